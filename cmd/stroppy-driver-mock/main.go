@@ -0,0 +1,12 @@
+// Command stroppy-driver-mock serves the mock driver, which records transactions for dry runs, as a driver plugin binary.
+package main
+
+import (
+	"github.com/stroppy-io/stroppy-core/pkg/plugins/driver"
+	"github.com/stroppy-io/stroppy-core/pkg/plugins/driver/mock"
+	"github.com/stroppy-io/stroppy-core/pkg/queries"
+)
+
+func main() {
+	driver.ServePlugin(mock.New(queries.PlaceholderDollar))
+}
@@ -0,0 +1,11 @@
+// Command stroppy-driver-sqlite serves the reference SQLite driver as a driver plugin binary.
+package main
+
+import (
+	"github.com/stroppy-io/stroppy-core/pkg/plugins/driver"
+	"github.com/stroppy-io/stroppy-core/pkg/plugins/driver/sqlite"
+)
+
+func main() {
+	driver.ServePlugin(sqlite.New())
+}
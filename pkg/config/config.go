@@ -0,0 +1,188 @@
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	stroppy "github.com/stroppy-io/stroppy-core/pkg/proto"
+	"github.com/stroppy-io/stroppy-core/pkg/validation"
+)
+
+var (
+	ErrNoConfigFiles      = errors.New("no config files given")
+	ErrIncompatibleConfig = errors.New("config version is incompatible with core version")
+)
+
+// Loader builds Config from files, environment and explicit overrides.
+type Loader struct {
+	environ   []string
+	overrides []string
+}
+
+// NewLoader creates Loader which reads overrides from the process environment.
+func NewLoader() *Loader {
+	return &Loader{environ: os.Environ()}
+}
+
+// WithEnviron replaces the environment, in os.Environ form, used for STROPPY_* overrides.
+func (l *Loader) WithEnviron(environ []string) *Loader {
+	l.environ = environ
+
+	return l
+}
+
+// WithOverrides adds "key.path=value" overrides, usually taken from --set flags.
+func (l *Loader) WithOverrides(overrides ...string) *Loader {
+	l.overrides = append(l.overrides, overrides...)
+
+	return l
+}
+
+// LoadConfig loads config from paths with NewLoader, see Loader.Load.
+func LoadConfig(paths ...string) (*stroppy.Config, error) {
+	return NewLoader().Load(paths...)
+}
+
+// Load reads and merges config files in order, later files override fields of earlier ones
+// (e.g. benchmark descriptor and then run profile). Then STROPPY_* environment variables
+// and explicit overrides are applied, defaults are filled and the result is validated.
+// Environment variables not matching any config field are ignored, variables of message,
+// whole list and whole map fields are rejected, use overrides to set them.
+func (l *Loader) Load(paths ...string) (*stroppy.Config, error) {
+	if len(paths) == 0 {
+		return nil, ErrNoConfigFiles
+	}
+
+	desc := (&stroppy.Config{}).ProtoReflect().Descriptor()
+	tree := make(map[string]any)
+
+	for _, path := range paths {
+		fileTree, err := readFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		normalizeKeys(fileTree, desc)
+		merge(tree, fileTree)
+	}
+
+	for _, override := range envOverrides(l.environ) {
+		if err := applyEnvSet(tree, desc, override); err != nil && !errors.Is(err, ErrUnknownField) {
+			return nil, err
+		}
+	}
+
+	for _, override := range l.overrides {
+		if err := applySet(tree, desc, override); err != nil {
+			return nil, err
+		}
+	}
+
+	data, err := json.Marshal(tree)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode merged config: %w", err)
+	}
+
+	config := &stroppy.Config{}
+	if err := protojson.Unmarshal(data, config); err != nil {
+		return nil, fmt.Errorf("failed to parse config: %w", err)
+	}
+
+	FillDefaults(config)
+
+	if err := Validate(config); err != nil {
+		return nil, err
+	}
+
+	return config, nil
+}
+
+// FillDefaults sets empty fields which have sensible defaults:
+// version of the core, random run id, info production logger
+// and all benchmark steps when none are requested.
+func FillDefaults(config *stroppy.Config) {
+	if config.GetVersion() == "" {
+		config.Version = stroppy.Version
+	}
+
+	run := config.GetRun()
+	if run == nil {
+		return
+	}
+
+	if run.GetRunId() == "" {
+		run.RunId = uuid.NewString()
+	}
+
+	if run.GetLogger() == nil {
+		run.Logger = &stroppy.LoggerConfig{
+			LogLevel: stroppy.LoggerConfig_LOG_LEVEL_INFO,
+			LogMode:  stroppy.LoggerConfig_LOG_MODE_PRODUCTION,
+		}
+	}
+
+	if len(run.GetSteps()) == 0 {
+		for _, step := range config.GetBenchmark().GetSteps() {
+			run.Steps = append(run.Steps, &stroppy.RequestedStep{Name: step.GetName()})
+		}
+	}
+}
+
+// Validate checks config with the generated validators, semantic benchmark validation
+// and compatibility of Config.Version with the core version.
+func Validate(config *stroppy.Config) error {
+	if err := config.ValidateAll(); err != nil {
+		return fmt.Errorf("invalid config: %w", err)
+	}
+
+	if err := validation.ValidateBenchmark(config.GetBenchmark()); err != nil {
+		return fmt.Errorf("invalid benchmark: %w", err)
+	}
+
+	return CheckVersion(config.GetVersion())
+}
+
+// CheckVersion checks that config of version can be run by the core of stroppy.Version:
+// major versions must be equal and the config minor version must not be newer.
+// While major version is 0, minor versions must be equal.
+func CheckVersion(version string) error {
+	configMajor, configMinor, err := parseVersion(version)
+	if err != nil {
+		return err
+	}
+
+	coreMajor, coreMinor, err := parseVersion(stroppy.Version)
+	if err != nil {
+		return err
+	}
+
+	if configMajor != coreMajor ||
+		configMinor > coreMinor ||
+		(coreMajor == 0 && configMinor != coreMinor) {
+		return fmt.Errorf("%w: config %s, core %s", ErrIncompatibleConfig, version, stroppy.Version)
+	}
+
+	return nil
+}
+
+func parseVersion(version string) (major, minor uint64, err error) {
+	parts := strings.SplitN(strings.TrimPrefix(version, "v"), ".", 3) //nolint: mnd // major.minor.patch
+
+	major, err = strconv.ParseUint(parts[0], 10, 64)
+	if err == nil && len(parts) > 1 {
+		minor, err = strconv.ParseUint(parts[1], 10, 64)
+	}
+
+	if err != nil {
+		return 0, 0, fmt.Errorf("%w: bad version '%s': %w", ErrIncompatibleConfig, version, err)
+	}
+
+	return major, minor, nil
+}
@@ -0,0 +1,185 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	stroppy "github.com/stroppy-io/stroppy-core/pkg/proto"
+)
+
+const benchmarkYAML = `
+benchmark:
+  name: bench
+  steps:
+    - name: workload
+      units:
+        - query:
+            name: select
+            sql: SELECT ${id}
+            count: 10
+            params:
+              - name: id
+                generationRule:
+                  int64Rules:
+                    range: {min: 1, max: 100}
+`
+
+const runJSON = `{
+  "run": {
+    "seed": "18446744073709551615",
+    "driver": {"driverPluginPath": "/bin/driver", "url": "postgres://localhost"},
+    "metadata": {"team": "core"}
+  }
+}`
+
+func writeFile(t *testing.T, name, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+
+	return path
+}
+
+func TestLoad(t *testing.T) {
+	benchmark := writeFile(t, "benchmark.yaml", benchmarkYAML)
+	run := writeFile(t, "run.json", runJSON)
+
+	config, err := NewLoader().WithEnviron(nil).Load(benchmark, run)
+	require.NoError(t, err)
+	require.Equal(t, stroppy.Version, config.GetVersion())
+	require.Equal(t, uint64(18446744073709551615), config.GetRun().GetSeed())
+	require.Equal(t, "postgres://localhost", config.GetRun().GetDriver().GetUrl())
+	require.NotEmpty(t, config.GetRun().GetRunId())
+	require.Equal(t, stroppy.LoggerConfig_LOG_LEVEL_INFO, config.GetRun().GetLogger().GetLogLevel())
+	require.Len(t, config.GetRun().GetSteps(), 1)
+	require.Equal(t, "workload", config.GetRun().GetSteps()[0].GetName())
+	require.Equal(t, int64(100), config.GetBenchmark().GetSteps()[0].GetUnits()[0].GetQuery().GetParams()[0].
+		GetGenerationRule().GetInt64Rules().GetRange().GetMax())
+}
+
+func TestLoad_Merge(t *testing.T) {
+	benchmark := writeFile(t, "benchmark.yaml", benchmarkYAML)
+	run := writeFile(t, "run.json", runJSON)
+	profile := writeFile(t, "profile.yaml", "run:\n  run_id: nightly\n  driver:\n    url: postgres://remote\n")
+
+	config, err := NewLoader().WithEnviron(nil).Load(benchmark, run, profile)
+	require.NoError(t, err)
+	require.Equal(t, "nightly", config.GetRun().GetRunId())
+	require.Equal(t, "postgres://remote", config.GetRun().GetDriver().GetUrl())
+	require.Equal(t, "/bin/driver", config.GetRun().GetDriver().GetDriverPluginPath())
+}
+
+func TestLoad_Jsonnet(t *testing.T) {
+	benchmark := writeFile(t, "benchmark.yaml", benchmarkYAML)
+	run := writeFile(t, "run.jsonnet", `{ run: { seed: 6 * 7, driver: { url: "postgres://" + "jsonnet" } } }`)
+
+	config, err := NewLoader().WithEnviron(nil).Load(benchmark, run)
+	require.NoError(t, err)
+	require.Equal(t, uint64(42), config.GetRun().GetSeed())
+	require.Equal(t, "postgres://jsonnet", config.GetRun().GetDriver().GetUrl())
+}
+
+func TestLoad_Overrides(t *testing.T) {
+	benchmark := writeFile(t, "benchmark.yaml", benchmarkYAML)
+	run := writeFile(t, "run.json", runJSON)
+
+	config, err := NewLoader().
+		WithEnviron([]string{
+			"STROPPY_RUN__SEED=7",
+			"STROPPY_RUN__DRIVER__URL=postgres://env",
+			"STROPPY_UNRELATED=1",
+			"HOME=/root",
+		}).
+		WithOverrides(
+			"run.seed=8",
+			"run.logger.log_level=LOG_LEVEL_ERROR",
+			"run.steps.0.name=workload",
+			"run.metadata.owner=me",
+			"version=0.1.0",
+		).
+		Load(benchmark, run)
+	require.NoError(t, err)
+	require.Equal(t, uint64(8), config.GetRun().GetSeed())
+	require.Equal(t, "postgres://env", config.GetRun().GetDriver().GetUrl())
+	require.Equal(t, stroppy.LoggerConfig_LOG_LEVEL_ERROR, config.GetRun().GetLogger().GetLogLevel())
+	require.Equal(t, map[string]string{"team": "core", "owner": "me"}, config.GetRun().GetMetadata())
+	require.Equal(t, "0.1.0", config.GetVersion())
+}
+
+func TestLoad_Errors(t *testing.T) {
+	benchmark := writeFile(t, "benchmark.yaml", benchmarkYAML)
+	run := writeFile(t, "run.json", runJSON)
+
+	tests := []struct {
+		name      string
+		paths     []string
+		overrides []string
+		err       error
+	}{
+		{"no files", nil, nil, ErrNoConfigFiles},
+		{"unsupported format", []string{writeFile(t, "config.toml", "")}, nil, ErrUnsupportedFormat},
+		{"unknown field", []string{benchmark, run}, []string{"run.unknown=1"}, ErrUnknownField},
+		{"bad value", []string{benchmark, run}, []string{"run.seed=abc"}, ErrInvalidOverride},
+		{"bad index", []string{benchmark, run}, []string{"run.steps.5.name=x"}, ErrInvalidOverride},
+		{"no value", []string{benchmark, run}, []string{"run.seed"}, ErrInvalidOverride},
+		{"incompatible version", []string{benchmark, run}, []string{"version=1.0.0"}, ErrIncompatibleConfig},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewLoader().WithEnviron(nil).WithOverrides(tt.overrides...).Load(tt.paths...)
+			require.ErrorIs(t, err, tt.err)
+		})
+	}
+}
+
+func TestLoad_EnvErrors(t *testing.T) {
+	benchmark := writeFile(t, "benchmark.yaml", benchmarkYAML)
+	run := writeFile(t, "run.json", runJSON)
+
+	tests := []struct {
+		name string
+		env  string
+	}{
+		{"message field", "STROPPY_RUN=foo"},
+		{"whole map", "STROPPY_RUN__METADATA=owner"},
+		{"list item message", "STROPPY_RUN__STEPS__0=workload"},
+		{"bad value", "STROPPY_RUN__SEED=abc"},
+		{"bad enum", "STROPPY_RUN__LOGGER__LOG_LEVEL=LOUD"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewLoader().WithEnviron([]string{tt.env}).Load(benchmark, run)
+			require.ErrorIs(t, err, ErrInvalidOverride)
+			name, _, _ := strings.Cut(tt.env, "=")
+			require.ErrorContains(t, err, name)
+		})
+	}
+
+	config, err := NewLoader().
+		WithEnviron([]string{
+			"STROPPY_RUN__DRIVER__HEALTH_CHECK_INTERVAL=10s",
+			"STROPPY_RUN__METADATA__Owner=env",
+			"STROPPY_RUN__STEPS__0__NAME=workload",
+		}).
+		Load(benchmark, run)
+	require.NoError(t, err)
+	require.Equal(t, 10*time.Second, config.GetRun().GetDriver().GetHealthCheckInterval().AsDuration())
+	require.Equal(t, "env", config.GetRun().GetMetadata()["Owner"])
+	require.Equal(t, "workload", config.GetRun().GetSteps()[0].GetName())
+}
+
+func TestCheckVersion(t *testing.T) {
+	require.NoError(t, CheckVersion(stroppy.Version))
+	require.NoError(t, CheckVersion("v0.1.0"))
+	require.ErrorIs(t, CheckVersion("0.2.0"), ErrIncompatibleConfig)
+	require.ErrorIs(t, CheckVersion("1.1.0"), ErrIncompatibleConfig)
+	require.ErrorIs(t, CheckVersion("latest"), ErrIncompatibleConfig)
+}
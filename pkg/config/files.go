@@ -0,0 +1,135 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/google/go-jsonnet"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"sigs.k8s.io/yaml"
+)
+
+var ErrUnsupportedFormat = errors.New("unsupported config file format")
+
+// readFile reads YAML, JSON (including protojson) or Jsonnet file into a generic JSON tree.
+func readFile(path string) (map[string]any, error) {
+	var (
+		data []byte
+		err  error
+	)
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		data, err = os.ReadFile(path)
+		if err == nil {
+			data, err = yaml.YAMLToJSON(data)
+		}
+	case ".json":
+		data, err = os.ReadFile(path)
+	case ".jsonnet", ".libsonnet":
+		var out string
+
+		out, err = jsonnet.MakeVM().EvaluateFile(path)
+		data = []byte(out)
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedFormat, path)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config file '%s': %w", path, err)
+	}
+
+	tree := make(map[string]any)
+	if err := decodeJSON(data, &tree); err != nil {
+		return nil, fmt.Errorf("failed to decode config file '%s': %w", path, err)
+	}
+
+	return tree, nil
+}
+
+// decodeJSON keeps numbers as json.Number so that 64-bit integers are not rounded.
+func decodeJSON(data []byte, target any) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	return decoder.Decode(target)
+}
+
+// normalizeKeys renames JSON names of message fields to proto names,
+// so that files written in both styles are merged field by field.
+func normalizeKeys(tree map[string]any, desc protoreflect.MessageDescriptor) {
+	for key, value := range tree {
+		field := findField(desc, key)
+		if field == nil {
+			continue
+		}
+
+		if name := string(field.Name()); name != key {
+			delete(tree, key)
+			tree[name] = value
+		}
+
+		if !isPlainMessage(field) {
+			continue
+		}
+
+		switch typed := value.(type) {
+		case map[string]any:
+			if field.IsMap() {
+				for _, item := range typed {
+					if nested, ok := item.(map[string]any); ok {
+						normalizeKeys(nested, field.MapValue().Message())
+					}
+				}
+			} else {
+				normalizeKeys(typed, field.Message())
+			}
+		case []any:
+			for _, item := range typed {
+				if nested, ok := item.(map[string]any); ok {
+					normalizeKeys(nested, field.Message())
+				}
+			}
+		}
+	}
+}
+
+// isPlainMessage reports whether field holds messages encoded as JSON objects with field names.
+// Well-known types have special JSON forms and are left as is.
+func isPlainMessage(field protoreflect.FieldDescriptor) bool {
+	message := field.Message()
+	if field.IsMap() {
+		message = field.MapValue().Message()
+	}
+
+	return message != nil && message.FullName().Parent() != "google.protobuf"
+}
+
+func findField(desc protoreflect.MessageDescriptor, name string) protoreflect.FieldDescriptor {
+	if field := desc.Fields().ByName(protoreflect.Name(name)); field != nil {
+		return field
+	}
+
+	return desc.Fields().ByJSONName(name)
+}
+
+// merge merges src into dst: objects are merged recursively, other values from src replace dst ones.
+func merge(dst, src map[string]any) {
+	for key, value := range src {
+		srcMap, srcIsMap := value.(map[string]any)
+		dstMap, dstIsMap := dst[key].(map[string]any)
+
+		if srcIsMap && dstIsMap {
+			merge(dstMap, srcMap)
+
+			continue
+		}
+
+		dst[key] = value
+	}
+}
@@ -0,0 +1,305 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// EnvPrefix marks environment variables which override config fields.
+	EnvPrefix = "STROPPY_"
+	// EnvPathSeparator separates field names in environment variable names,
+	// e.g. STROPPY_RUN__DRIVER__URL overrides run.driver.url.
+	EnvPathSeparator = "__"
+
+	wellKnownTypesPrefix = "google.protobuf."
+)
+
+var (
+	ErrInvalidOverride = errors.New("invalid config override")
+	ErrUnknownField    = errors.New("unknown config field")
+)
+
+// applySet applies override in "key.path=value" form, e.g. "run.seed=42" or "run.steps.0.name=load".
+// Repeated fields are addressed by index, index equal to the length appends a new item.
+func applySet(tree map[string]any, desc protoreflect.MessageDescriptor, override string) error {
+	path, value, found := strings.Cut(override, "=")
+	if !found || path == "" {
+		return fmt.Errorf("%w: '%s', expected key.path=value", ErrInvalidOverride, override)
+	}
+
+	if err := setPath(tree, desc, strings.Split(path, "."), value); err != nil {
+		return fmt.Errorf("failed to apply override '%s': %w", override, err)
+	}
+
+	return nil
+}
+
+// envOverride is an override taken from the environment variable name.
+type envOverride struct {
+	name  string
+	path  []string
+	value string
+}
+
+// envOverrides converts STROPPY_* variables of environ to overrides. Path segments keep their case,
+// field names are lowercased by applyEnvSet, so map keys like STROPPY_RUN__METADATA__Owner keep theirs.
+func envOverrides(environ []string) []envOverride {
+	overrides := make([]envOverride, 0)
+
+	for _, env := range environ {
+		name, value, found := strings.Cut(env, "=")
+		if !found || !strings.HasPrefix(name, EnvPrefix) {
+			continue
+		}
+
+		overrides = append(overrides, envOverride{
+			name:  name,
+			path:  strings.Split(strings.TrimPrefix(name, EnvPrefix), EnvPathSeparator),
+			value: value,
+		})
+	}
+
+	return overrides
+}
+
+// applyEnvSet applies override of an environment variable, unlike applySet it sets only scalar fields,
+// items of scalar lists and values of scalar maps. Well-known types like google.protobuf.Duration are scalars.
+func applyEnvSet(tree map[string]any, desc protoreflect.MessageDescriptor, override envOverride) error {
+	path, err := scalarEnvPath(desc, override.path)
+	if err != nil {
+		return fmt.Errorf("failed to apply environment variable %s: %w", override.name, err)
+	}
+
+	if err := applySet(tree, desc, strings.Join(path, ".")+"="+override.value); err != nil {
+		return fmt.Errorf("failed to apply environment variable %s: %w", override.name, err)
+	}
+
+	return nil
+}
+
+// scalarEnvPath checks that path leads to a scalar and returns it with field names lowercased,
+// list indexes and map keys are kept as is.
+func scalarEnvPath(desc protoreflect.MessageDescriptor, path []string) ([]string, error) {
+	name := strings.ToLower(path[0])
+
+	field := findField(desc, name)
+	if field == nil {
+		return nil, fmt.Errorf("%w: '%s' in %s", ErrUnknownField, name, desc.FullName())
+	}
+
+	valueField, resolved, rest := field, []string{name}, path[1:]
+
+	if field.IsList() || field.IsMap() {
+		if len(rest) == 0 {
+			return nil, fmt.Errorf("%w: '%s' is not a scalar field", ErrInvalidOverride, field.Name())
+		}
+
+		if field.IsMap() {
+			valueField = field.MapValue()
+		}
+
+		resolved, rest = append(resolved, rest[0]), rest[1:]
+	}
+
+	message := valueField.Message()
+	if message == nil || strings.HasPrefix(string(message.FullName()), wellKnownTypesPrefix) {
+		// Paths into scalars are reported by setPath.
+		return append(resolved, rest...), nil
+	}
+
+	if len(rest) == 0 {
+		return nil, fmt.Errorf("%w: '%s' is not a scalar field", ErrInvalidOverride, field.Name())
+	}
+
+	nested, err := scalarEnvPath(message, rest)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(resolved, nested...), nil
+}
+
+func setPath(tree map[string]any, desc protoreflect.MessageDescriptor, path []string, raw string) error {
+	field := findField(desc, path[0])
+	if field == nil {
+		return fmt.Errorf("%w: '%s' in %s", ErrUnknownField, path[0], desc.FullName())
+	}
+
+	key := string(field.Name())
+	if field.JSONName() != key {
+		if value, ok := tree[field.JSONName()]; ok {
+			delete(tree, field.JSONName())
+			tree[key] = value
+		}
+	}
+
+	if len(path) == 1 {
+		value, err := parseValue(field, raw)
+		if err != nil {
+			return err
+		}
+
+		tree[key] = value
+
+		return nil
+	}
+
+	switch {
+	case field.IsList():
+		return setListItem(tree, key, field, path[1:], raw)
+	case field.IsMap():
+		items, _ := tree[key].(map[string]any)
+		if items == nil {
+			items = make(map[string]any)
+			tree[key] = items
+		}
+
+		return setMapItem(items, field, path[1:], raw)
+	case field.Message() != nil:
+		nested, _ := tree[key].(map[string]any)
+		if nested == nil {
+			nested = make(map[string]any)
+			tree[key] = nested
+		}
+
+		return setPath(nested, field.Message(), path[1:], raw)
+	default:
+		return fmt.Errorf("%w: '%s' is not a message", ErrInvalidOverride, key)
+	}
+}
+
+func setListItem(
+	tree map[string]any,
+	key string,
+	field protoreflect.FieldDescriptor,
+	path []string,
+	raw string,
+) error {
+	items, _ := tree[key].([]any)
+
+	idx, err := strconv.Atoi(path[0])
+	if err != nil || idx < 0 || idx > len(items) {
+		return fmt.Errorf("%w: bad index '%s' of '%s' with %d items", ErrInvalidOverride, path[0], key, len(items))
+	}
+
+	if idx == len(items) {
+		items = append(items, nil)
+		tree[key] = items
+	}
+
+	if len(path) == 1 {
+		value, err := parseScalar(field, raw)
+		if err != nil {
+			return err
+		}
+
+		items[idx] = value
+
+		return nil
+	}
+
+	if field.Message() == nil {
+		return fmt.Errorf("%w: items of '%s' are not messages", ErrInvalidOverride, key)
+	}
+
+	nested, _ := items[idx].(map[string]any)
+	if nested == nil {
+		nested = make(map[string]any)
+		items[idx] = nested
+	}
+
+	return setPath(nested, field.Message(), path[1:], raw)
+}
+
+func setMapItem(items map[string]any, field protoreflect.FieldDescriptor, path []string, raw string) error {
+	valueField := field.MapValue()
+
+	if len(path) == 1 {
+		value, err := parseScalar(valueField, raw)
+		if err != nil {
+			return err
+		}
+
+		items[path[0]] = value
+
+		return nil
+	}
+
+	if valueField.Message() == nil {
+		return fmt.Errorf("%w: values of '%s' are not messages", ErrInvalidOverride, field.Name())
+	}
+
+	nested, _ := items[path[0]].(map[string]any)
+	if nested == nil {
+		nested = make(map[string]any)
+		items[path[0]] = nested
+	}
+
+	return setPath(nested, valueField.Message(), path[1:], raw)
+}
+
+// parseValue parses raw as the whole field value: lists and maps are given in YAML flow style.
+func parseValue(field protoreflect.FieldDescriptor, raw string) (any, error) {
+	if field.IsList() || field.IsMap() {
+		return parseYAML(raw)
+	}
+
+	return parseScalar(field, raw)
+}
+
+// parseScalar parses raw as a single value of field kind. Strings and enum names are taken as is,
+// enums also accept numbers, messages are parsed from YAML or JSON.
+func parseScalar(field protoreflect.FieldDescriptor, raw string) (any, error) {
+	var (
+		value any
+		err   error
+	)
+
+	switch field.Kind() {
+	case protoreflect.StringKind, protoreflect.BytesKind:
+		return raw, nil
+	case protoreflect.EnumKind:
+		if field.Enum().Values().ByName(protoreflect.Name(raw)) != nil {
+			return raw, nil
+		}
+
+		value, err = strconv.ParseInt(raw, 10, 32)
+	case protoreflect.BoolKind:
+		value, err = strconv.ParseBool(raw)
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		value, err = strconv.ParseInt(raw, 10, 64)
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		value, err = strconv.ParseUint(raw, 10, 64)
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		value, err = strconv.ParseFloat(raw, 64)
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return parseYAML(raw)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("%w: '%s' for field '%s': %w", ErrInvalidOverride, raw, field.Name(), err)
+	}
+
+	return value, nil
+}
+
+func parseYAML(raw string) (any, error) {
+	data, err := yaml.YAMLToJSON([]byte(raw))
+	if err != nil {
+		return nil, fmt.Errorf("%w: '%s': %w", ErrInvalidOverride, raw, err)
+	}
+
+	var value any
+	if err := decodeJSON(data, &value); err != nil {
+		return nil, fmt.Errorf("%w: '%s': %w", ErrInvalidOverride, raw, err)
+	}
+
+	return value, nil
+}
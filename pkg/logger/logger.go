@@ -1,7 +1,11 @@
 package logger
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
 	"os"
+	"strconv"
 	"strings"
 	"sync/atomic"
 
@@ -9,6 +13,8 @@ import (
 	"go.uber.org/zap/zapcore"
 )
 
+var ErrInvalidEnv = errors.New("invalid logger environment")
+
 type LogMod string
 
 const (
@@ -16,9 +22,29 @@ const (
 	ProductionMod  LogMod = "production"
 )
 
+type LogFormat string
+
+const (
+	JSONFormat    LogFormat = "json"
+	ConsoleFormat LogFormat = "console"
+)
+
 type Config struct {
 	LogMod   LogMod `default:"production" mapstructure:"mod"   validate:"oneof=production development"`
 	LogLevel string `default:"info"       mapstructure:"level" validate:"oneof=debug info warn error"`
+	// LogFormat overrides encoding of the mode if set.
+	LogFormat LogFormat `mapstructure:"format" validate:"omitempty,oneof=json console"`
+	// Sampling overrides sampling of the mode if set, zero SamplingConfig disables sampling.
+	Sampling *SamplingConfig `mapstructure:"sampling"`
+	// OutputPaths overrides output of the mode if not empty.
+	OutputPaths []string `mapstructure:"output"`
+}
+
+// SamplingConfig limits entries with the same level and message logged each second,
+// see zap.SamplingConfig.
+type SamplingConfig struct {
+	Initial    int `mapstructure:"initial"`
+	Thereafter int `mapstructure:"thereafter"`
 }
 
 var globalLogger = atomic.Pointer[zap.Logger]{} //nolint:gochecknoglobals // global logger needed for all app.
@@ -54,14 +80,7 @@ func newZapCfg(mod LogMod, logLevel zapcore.Level) zap.Config {
 
 // NewFromConfig creates new logger from config.
 func NewFromConfig(cfg *Config, opts ...zap.Option) *zap.Logger {
-	level, parseErr := zapcore.ParseLevel(cfg.LogLevel)
-	if parseErr != nil {
-		panic(parseErr)
-	}
-
-	zapCfg := newZapCfg(cfg.LogMod, level)
-
-	logger, err := zapCfg.Build(opts...)
+	logger, err := newFromConfig(cfg, opts...)
 	if err != nil {
 		panic(err)
 	}
@@ -71,23 +90,153 @@ func NewFromConfig(cfg *Config, opts ...zap.Option) *zap.Logger {
 	return Global()
 }
 
+func newFromConfig(cfg *Config, opts ...zap.Option) (*zap.Logger, error) {
+	level, err := zapcore.ParseLevel(cfg.LogLevel)
+	if err != nil {
+		return nil, err
+	}
+
+	zapCfg := newZapCfg(cfg.LogMod, level)
+	applyOverrides(&zapCfg, cfg)
+
+	return zapCfg.Build(opts...)
+}
+
+// applyOverrides applies settings of cfg which are set over the config of the mode.
+func applyOverrides(zapCfg *zap.Config, cfg *Config) {
+	if cfg.LogFormat != "" {
+		zapCfg.Encoding = string(cfg.LogFormat)
+	}
+
+	switch {
+	case cfg.Sampling == nil:
+	case *cfg.Sampling == SamplingConfig{}:
+		zapCfg.Sampling = nil
+	default:
+		zapCfg.Sampling = &zap.SamplingConfig{
+			Initial:    cfg.Sampling.Initial,
+			Thereafter: cfg.Sampling.Thereafter,
+		}
+	}
+
+	if len(cfg.OutputPaths) > 0 {
+		zapCfg.OutputPaths = cfg.OutputPaths
+	}
+}
+
 const (
-	envLogLevel = "LOG_LEVEL"
-	envLogMod   = "LOG_MODE"
+	envLogLevel    = "LOG_LEVEL"
+	envLogMod      = "LOG_MODE"
+	envLogFormat   = "LOG_FORMAT"
+	envLogSampling = "LOG_SAMPLING"
+	envLogOutput   = "LOG_OUTPUT"
+
+	samplingSeparator = "/"
 )
 
+// SetLoggerEnv sets logger settings read by NewFromEnv in the environment of the current process.
+//
+// Deprecated: pass Config.Env to the environment of the plugin command instead.
 func SetLoggerEnv(level zapcore.Level, mod LogMod) {
 	os.Setenv(envLogLevel, strings.ToLower(level.String()))
 	os.Setenv(envLogMod, strings.ToLower(string(mod)))
 }
 
-func NewFromEnv(opts ...zap.Option) *zap.Logger {
+// Env returns environment variables passing cfg to NewFromEnv of another process, e.g. a plugin.
+// Variables of settings which are not set are omitted, output paths are passed as a JSON array.
+func (c *Config) Env() map[string]string {
+	env := map[string]string{
+		envLogLevel: strings.ToLower(c.LogLevel),
+		envLogMod:   strings.ToLower(string(c.LogMod)),
+	}
+
+	if c.LogFormat != "" {
+		env[envLogFormat] = string(c.LogFormat)
+	}
+
+	if c.Sampling != nil {
+		env[envLogSampling] = strconv.Itoa(c.Sampling.Initial) + samplingSeparator + strconv.Itoa(c.Sampling.Thereafter)
+	}
+
+	if len(c.OutputPaths) > 0 {
+		output, _ := json.Marshal(c.OutputPaths) //nolint: errchkjson // strings are always encoded
+		env[envLogOutput] = string(output)
+	}
+
+	return env
+}
+
+// ConfigFromEnv reads Config from the environment of the current process, see Config.Env.
+// Malformed variables are left unset in the returned config and reported in the error.
+func ConfigFromEnv() (*Config, error) {
+	var errs []error
+
 	cfg := &Config{
-		LogLevel: os.Getenv(envLogLevel),
-		LogMod:   LogMod(os.Getenv(envLogMod)),
+		LogLevel:  os.Getenv(envLogLevel),
+		LogMod:    LogMod(os.Getenv(envLogMod)),
+		LogFormat: LogFormat(os.Getenv(envLogFormat)),
+	}
+
+	if _, err := zapcore.ParseLevel(cfg.LogLevel); err != nil {
+		errs = append(errs, fmt.Errorf("%w: %s=%q", ErrInvalidEnv, envLogLevel, cfg.LogLevel))
+		cfg.LogLevel = ""
+	}
+
+	if cfg.LogFormat != "" && cfg.LogFormat != JSONFormat && cfg.LogFormat != ConsoleFormat {
+		errs = append(errs, fmt.Errorf("%w: %s=%q", ErrInvalidEnv, envLogFormat, cfg.LogFormat))
+		cfg.LogFormat = ""
+	}
+
+	if sampling := os.Getenv(envLogSampling); sampling != "" {
+		initial, thereafter, _ := strings.Cut(sampling, samplingSeparator)
+
+		initialCount, initialErr := strconv.Atoi(initial)
+		thereafterCount, thereafterErr := strconv.Atoi(thereafter)
+
+		if initialErr != nil || thereafterErr != nil {
+			errs = append(errs, fmt.Errorf("%w: %s=%q, expected initial%sthereafter",
+				ErrInvalidEnv, envLogSampling, sampling, samplingSeparator))
+		} else {
+			cfg.Sampling = &SamplingConfig{Initial: initialCount, Thereafter: thereafterCount}
+		}
+	}
+
+	if output := os.Getenv(envLogOutput); output != "" {
+		if err := json.Unmarshal([]byte(output), &cfg.OutputPaths); err != nil {
+			errs = append(errs, fmt.Errorf("%w: %s=%q, expected JSON array of paths: %w",
+				ErrInvalidEnv, envLogOutput, output, err))
+			cfg.OutputPaths = nil
+		}
 	}
 
-	return NewFromConfig(cfg, opts...)
+	return cfg, errors.Join(errs...)
+}
+
+// NewFromEnv creates new logger from the environment set with Config.Env.
+// It does not fail: malformed variables and output paths which can not be opened fall back
+// to defaults of the mode, and the created logger warns about them.
+func NewFromEnv(opts ...zap.Option) *zap.Logger {
+	cfg, envErr := ConfigFromEnv()
+
+	logger, err := newFromConfig(cfg, opts...)
+	if err != nil {
+		envErr = errors.Join(envErr, fmt.Errorf("failed to open %s outputs: %w", envLogOutput, err))
+		cfg.OutputPaths = nil
+
+		logger, err = newFromConfig(cfg, opts...)
+	}
+
+	if err != nil {
+		logger = newDefault(opts...)
+	}
+
+	if envErr != nil {
+		logger.Warn("invalid logger environment, defaults are used instead", zap.Error(envErr))
+	}
+
+	setGlobalLogger(logger)
+
+	return Global()
 }
 
 func setGlobalLogger(logger *zap.Logger) {
@@ -4,7 +4,11 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+
+	stroppy "github.com/stroppy-io/stroppy-core/pkg/proto"
 )
 
 func TestNewDefault(t *testing.T) {
@@ -81,3 +85,91 @@ func TestNewStructLogger(t *testing.T) {
 
 	require.NotNil(t, logger.Named("test"))
 }
+
+func TestConfig_Env(t *testing.T) {
+	config := &Config{
+		LogMod:      ProductionMod,
+		LogLevel:    "warn",
+		LogFormat:   ConsoleFormat,
+		Sampling:    &SamplingConfig{Initial: 10, Thereafter: 5},
+		OutputPaths: []string{"stderr", "/tmp/plugin,1.log"},
+	}
+
+	for key, value := range config.Env() {
+		t.Setenv(key, value)
+	}
+
+	fromEnv, err := ConfigFromEnv()
+	require.NoError(t, err)
+	require.Equal(t, config, fromEnv)
+
+	t.Setenv(envLogSampling, "often")
+	t.Setenv(envLogOutput, "stderr,/tmp/plugin.log")
+
+	fromEnv, err = ConfigFromEnv()
+	require.ErrorIs(t, err, ErrInvalidEnv)
+	require.ErrorContains(t, err, envLogSampling)
+	require.ErrorContains(t, err, envLogOutput)
+	require.Nil(t, fromEnv.Sampling)
+	require.Nil(t, fromEnv.OutputPaths)
+	require.Equal(t, "warn", fromEnv.LogLevel)
+}
+
+func TestNewFromEnv_InvalidEnv(t *testing.T) {
+	t.Setenv(envLogLevel, "loud")
+	t.Setenv(envLogMod, string(ProductionMod))
+	t.Setenv(envLogSampling, "often")
+	t.Setenv(envLogOutput, `["/nonexistent/dir/plugin.log"]`)
+
+	core, logs := observer.New(zapcore.WarnLevel)
+
+	var logger *zap.Logger
+
+	require.NotPanics(t, func() {
+		logger = NewFromEnv(zap.WrapCore(func(c zapcore.Core) zapcore.Core { return zapcore.NewTee(c, core) }))
+	})
+	require.True(t, logger.Core().Enabled(zapcore.InfoLevel))
+	require.False(t, logger.Core().Enabled(zapcore.DebugLevel))
+
+	warnings := logs.FilterMessage("invalid logger environment, defaults are used instead").All()
+	require.Len(t, warnings, 1)
+
+	warning := warnings[0].ContextMap()["error"]
+	require.Contains(t, warning, envLogLevel)
+	require.Contains(t, warning, envLogSampling)
+	require.Contains(t, warning, envLogOutput)
+}
+
+func TestApplyOverrides(t *testing.T) {
+	zapCfg := newZapCfg(ProductionMod, zapcore.InfoLevel)
+	applyOverrides(&zapCfg, &Config{})
+	require.Equal(t, "json", zapCfg.Encoding)
+	require.NotNil(t, zapCfg.Sampling)
+
+	applyOverrides(&zapCfg, &Config{
+		LogFormat:   ConsoleFormat,
+		Sampling:    &SamplingConfig{},
+		OutputPaths: []string{"stdout"},
+	})
+	require.Equal(t, "console", zapCfg.Encoding)
+	require.Nil(t, zapCfg.Sampling)
+	require.Equal(t, []string{"stdout"}, zapCfg.OutputPaths)
+}
+
+func TestConfigFromProto(t *testing.T) {
+	config := ConfigFromProto(&stroppy.LoggerConfig{
+		LogLevel:    stroppy.LoggerConfig_LOG_LEVEL_ERROR,
+		LogMode:     stroppy.LoggerConfig_LOG_MODE_PRODUCTION,
+		LogFormat:   stroppy.LoggerConfig_LOG_FORMAT_JSON,
+		Sampling:    &stroppy.LoggerConfig_Sampling{Initial: 1, Thereafter: 2},
+		OutputPaths: []string{"stderr"},
+	})
+
+	require.Equal(t, &Config{
+		LogMod:      ProductionMod,
+		LogLevel:    "error",
+		LogFormat:   JSONFormat,
+		Sampling:    &SamplingConfig{Initial: 1, Thereafter: 2},
+		OutputPaths: []string{"stderr"},
+	}, config)
+}
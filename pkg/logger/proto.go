@@ -35,9 +35,36 @@ func ModeFromProtoConfig(mode stroppy.LoggerConfig_LogMode) LogMod {
 	}
 }
 
+func FormatFromProtoConfig(format stroppy.LoggerConfig_LogFormat) LogFormat {
+	switch format {
+	case stroppy.LoggerConfig_LOG_FORMAT_JSON:
+		return JSONFormat
+	case stroppy.LoggerConfig_LOG_FORMAT_CONSOLE:
+		return ConsoleFormat
+	default:
+		return ""
+	}
+}
+
+// ConfigFromProto converts LoggerConfig of the run to Config.
+func ConfigFromProto(config *stroppy.LoggerConfig) *Config {
+	cfg := &Config{
+		LogMod:      ModeFromProtoConfig(config.GetLogMode()),
+		LogLevel:    LevelFromProtoConfig(config.GetLogLevel()).String(),
+		LogFormat:   FormatFromProtoConfig(config.GetLogFormat()),
+		OutputPaths: config.GetOutputPaths(),
+	}
+
+	if sampling := config.GetSampling(); sampling != nil {
+		cfg.Sampling = &SamplingConfig{
+			Initial:    int(sampling.GetInitial()),
+			Thereafter: int(sampling.GetThereafter()),
+		}
+	}
+
+	return cfg
+}
+
 func NewFromProtoConfig(config *stroppy.LoggerConfig) *zap.Logger {
-	return NewFromConfig(&Config{
-		LogMod:   ModeFromProtoConfig(config.GetLogMode()),
-		LogLevel: LevelFromProtoConfig(config.GetLogLevel()).String(),
-	})
+	return NewFromConfig(ConfigFromProto(config))
 }
@@ -0,0 +1,117 @@
+package common
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"os/exec"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/go-plugin"
+
+	"github.com/stroppy-io/stroppy-core/pkg/logger"
+	stroppy "github.com/stroppy-io/stroppy-core/pkg/proto"
+)
+
+var ErrInvalidChecksum = errors.New("invalid plugin sha256 checksum")
+
+// CommandConfig describes how to start a plugin process.
+type CommandConfig struct {
+	Path    string
+	Args    []string
+	Env     map[string]string
+	Workdir string
+	// SHA256 is a hex encoded checksum of the binary at Path, not verified if empty.
+	SHA256 string
+}
+
+// CommandFromDriverConfig returns CommandConfig of the driver plugin.
+func CommandFromDriverConfig(config *stroppy.DriverConfig) *CommandConfig {
+	return &CommandConfig{
+		Path:    config.GetDriverPluginPath(),
+		Args:    config.GetDriverPluginArgs(),
+		Env:     config.GetDriverPluginEnv(),
+		Workdir: config.GetDriverPluginWorkdir(),
+		SHA256:  config.GetDriverPluginSha256(),
+	}
+}
+
+// CommandFromPlugin returns CommandConfig of the plugin described in RunConfig.Plugins.
+func CommandFromPlugin(config *stroppy.Plugin) *CommandConfig {
+	return &CommandConfig{
+		Path:    config.GetPath(),
+		Args:    config.GetArgs(),
+		Env:     config.GetEnv(),
+		Workdir: config.GetWorkdir(),
+		SHA256:  config.GetSha256(),
+	}
+}
+
+// WithLogger returns a copy of the command passing config to logger.NewFromEnv of the plugin process
+// in its environment, variables set in Env take precedence.
+func (c *CommandConfig) WithLogger(config *logger.Config) *CommandConfig {
+	command := *c
+	command.Env = config.Env()
+
+	maps.Copy(command.Env, c.Env)
+
+	return &command
+}
+
+// NewCommand creates the plugin process command. The binary is executed directly with argv,
+// without shell, so paths and args may contain spaces and quotes.
+// Env is added to the environment of the current process.
+func (c *CommandConfig) NewCommand() *exec.Cmd {
+	cmd := exec.Command(c.Path, c.Args...) //nolint: gosec // plugin binary comes from config
+	cmd.Dir = c.Workdir
+
+	if len(c.Env) > 0 {
+		keys := make([]string, 0, len(c.Env))
+		for key := range c.Env {
+			keys = append(keys, key)
+		}
+
+		slices.Sort(keys)
+
+		cmd.Env = os.Environ()
+		for _, key := range keys {
+			cmd.Env = append(cmd.Env, key+"="+c.Env[key])
+		}
+	}
+
+	return cmd
+}
+
+// ConfigureClient sets the command, its checksum verification and environment in config of go-plugin client.
+// go-plugin appends the host environment after the one of the command, so the host would override Env,
+// it is skipped when the command carries the host environment itself.
+func (c *CommandConfig) ConfigureClient(config *plugin.ClientConfig) error {
+	secureConfig, err := c.SecureConfig()
+	if err != nil {
+		return err
+	}
+
+	config.Cmd = c.NewCommand()
+	config.SecureConfig = secureConfig
+	config.SkipHostEnv = config.Cmd.Env != nil
+
+	return nil
+}
+
+// SecureConfig returns go-plugin SecureConfig verifying the binary checksum, nil if SHA256 is empty.
+func (c *CommandConfig) SecureConfig() (*plugin.SecureConfig, error) {
+	if c.SHA256 == "" {
+		return nil, nil //nolint: nilnil // no verification needed
+	}
+
+	checksum, err := hex.DecodeString(strings.TrimSpace(c.SHA256))
+	if err != nil || len(checksum) != sha256.Size {
+		return nil, fmt.Errorf("%w: '%s'", ErrInvalidChecksum, c.SHA256)
+	}
+
+	return &plugin.SecureConfig{Checksum: checksum, Hash: sha256.New()}, nil
+}
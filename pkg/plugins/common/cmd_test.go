@@ -0,0 +1,116 @@
+package common
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/go-plugin"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/stroppy-io/stroppy-core/pkg/logger"
+	stroppy "github.com/stroppy-io/stroppy-core/pkg/proto"
+)
+
+func TestCommandConfig_NewCommand(t *testing.T) {
+	command := CommandFromDriverConfig(&stroppy.DriverConfig{
+		DriverPluginPath:    "/opt/my plugins/driver",
+		DriverPluginArgs:    []string{"--name", "it's a \"name\"", "$(rm -rf /)"},
+		DriverPluginEnv:     map[string]string{"B": "2", "A": "1"},
+		DriverPluginWorkdir: "/tmp",
+	})
+
+	cmd := command.NewCommand()
+	require.Equal(t, "/opt/my plugins/driver", cmd.Path)
+	require.Equal(t, []string{"/opt/my plugins/driver", "--name", "it's a \"name\"", "$(rm -rf /)"}, cmd.Args)
+	require.Equal(t, "/tmp", cmd.Dir)
+	require.Equal(t, []string{"A=1", "B=2"}, cmd.Env[len(cmd.Env)-2:])
+}
+
+func TestCommandConfig_NewCommandInheritsEnv(t *testing.T) {
+	cmd := CommandFromPlugin(&stroppy.Plugin{Path: "/bin/sidecar"}).NewCommand()
+	require.Nil(t, cmd.Env)
+	require.Empty(t, cmd.Dir)
+}
+
+func TestCommandConfig_WithLogger(t *testing.T) {
+	command := CommandFromPlugin(&stroppy.Plugin{Path: "/bin/sidecar", Env: map[string]string{"LOG_MODE": "development"}})
+	withLogger := command.WithLogger(&logger.Config{LogMod: logger.ProductionMod, LogLevel: "warn"})
+
+	require.Equal(t, map[string]string{"LOG_LEVEL": "warn", "LOG_MODE": "development"}, withLogger.Env)
+	require.Equal(t, map[string]string{"LOG_MODE": "development"}, command.Env)
+	require.Contains(t, withLogger.NewCommand().Env, "LOG_LEVEL=warn")
+}
+
+func TestCommandConfig_ConfigureClientEnv(t *testing.T) {
+	t.Setenv("STROPPY_TEST_PLUGIN_ENV", "host")
+	t.Setenv("STROPPY_TEST_HOST_ONLY", "inherited")
+
+	tests := []struct {
+		name string
+		env  map[string]string
+		want string
+	}{
+		{"plugin env overrides host", map[string]string{"STROPPY_TEST_PLUGIN_ENV": "plugin"}, "plugin inherited"},
+		{"host env inherited", nil, "host inherited"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// The process prints its environment and exits before the handshake.
+			command := &CommandConfig{
+				Path: "/bin/sh",
+				Args: []string{"-c", `echo "$STROPPY_TEST_PLUGIN_ENV $STROPPY_TEST_HOST_ONLY" >&2`},
+				Env:  tt.env,
+			}
+			stderr := NewTailWriter(io.Discard, 1)
+			config := &plugin.ClientConfig{
+				HandshakeConfig: plugin.HandshakeConfig{ProtocolVersion: 1, MagicCookieKey: "K", MagicCookieValue: "V"},
+				Plugins:         plugin.PluginSet{},
+				Stderr:          stderr,
+				Logger:          NewLogger(zap.NewNop()),
+			}
+			require.NoError(t, command.ConfigureClient(config))
+
+			client := plugin.NewClient(config)
+			_, err := client.Start()
+			require.Error(t, err)
+			client.Kill()
+
+			require.Equal(t, []string{tt.want}, stderr.Lines())
+		})
+	}
+}
+
+func TestCommandConfig_SecureConfig(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "plugin")
+	content := []byte("binary")
+	require.NoError(t, os.WriteFile(path, content, 0o600))
+
+	sum := sha256.Sum256(content)
+
+	secure, err := (&CommandConfig{Path: path}).SecureConfig()
+	require.NoError(t, err)
+	require.Nil(t, secure)
+
+	secure, err = (&CommandConfig{Path: path, SHA256: hex.EncodeToString(sum[:])}).SecureConfig()
+	require.NoError(t, err)
+
+	ok, err := secure.Check(path)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	secure, err = (&CommandConfig{Path: path, SHA256: hex.EncodeToString(make([]byte, sha256.Size))}).SecureConfig()
+	require.NoError(t, err)
+
+	ok, err = secure.Check(path)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	_, err = (&CommandConfig{Path: path, SHA256: "abc"}).SecureConfig()
+	require.ErrorIs(t, err, ErrInvalidChecksum)
+}
@@ -0,0 +1,95 @@
+package common
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+)
+
+var ErrPluginExited = errors.New("plugin process exited")
+
+// ExitError describes exit of a plugin process with its exit code and last lines of stderr.
+type ExitError struct {
+	// Code is the exit code of the process, -1 if it was killed by a signal or is unknown.
+	Code   int
+	Stderr []string
+}
+
+// NewExitError returns ExitError of the process with state, nil state means unknown exit code.
+func NewExitError(state *os.ProcessState, stderr []string) *ExitError {
+	code := -1
+	if state != nil {
+		code = state.ExitCode()
+	}
+
+	return &ExitError{Code: code, Stderr: stderr}
+}
+
+func (e *ExitError) Error() string {
+	msg := fmt.Sprintf("%s with code %d", ErrPluginExited, e.Code)
+	if len(e.Stderr) == 0 {
+		return msg
+	}
+
+	return msg + ", last stderr lines:\n" + strings.Join(e.Stderr, "\n")
+}
+
+func (e *ExitError) Unwrap() error {
+	return ErrPluginExited
+}
+
+// TailWriter passes everything to the underlying writer and keeps the last lines written.
+type TailWriter struct {
+	mu      sync.Mutex
+	writer  io.Writer
+	size    int
+	lines   []string
+	partial []byte
+}
+
+// NewTailWriter creates TailWriter keeping size last lines written to writer.
+func NewTailWriter(writer io.Writer, size int) *TailWriter {
+	return &TailWriter{writer: writer, size: size}
+}
+
+func (t *TailWriter) Write(data []byte) (int, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.partial = append(t.partial, data...)
+
+	for {
+		idx := bytes.IndexByte(t.partial, '\n')
+		if idx < 0 {
+			break
+		}
+
+		t.lines = append(t.lines, string(t.partial[:idx]))
+		t.partial = t.partial[idx+1:]
+	}
+
+	if len(t.lines) > t.size {
+		t.lines = t.lines[len(t.lines)-t.size:]
+	}
+
+	return t.writer.Write(data)
+}
+
+// Lines returns the last lines written including the unfinished one.
+func (t *TailWriter) Lines() []string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	lines := make([]string, 0, len(t.lines)+1)
+	lines = append(lines, t.lines...)
+
+	if len(t.partial) > 0 {
+		lines = append(lines, string(t.partial))
+	}
+
+	return lines
+}
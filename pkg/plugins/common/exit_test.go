@@ -0,0 +1,30 @@
+package common
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTailWriter(t *testing.T) {
+	var out bytes.Buffer
+
+	tail := NewTailWriter(&out, 2)
+
+	for _, chunk := range []string{"first\n", "second", "\n", "third\nfou"} {
+		_, err := tail.Write([]byte(chunk))
+		require.NoError(t, err)
+	}
+
+	require.Equal(t, "first\nsecond\nthird\nfou", out.String())
+	require.Equal(t, []string{"second", "third", "fou"}, tail.Lines())
+}
+
+func TestExitError(t *testing.T) {
+	err := NewExitError(nil, []string{"panic: boom"})
+
+	require.ErrorIs(t, err, ErrPluginExited)
+	require.Equal(t, -1, err.Code)
+	require.EqualError(t, err, "plugin process exited with code -1, last stderr lines:\npanic: boom")
+}
@@ -0,0 +1,186 @@
+package common
+
+import (
+	"bytes"
+	"encoding/json"
+	"path/filepath"
+	"slices"
+	"sync"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/stroppy-io/stroppy-core/pkg/logger"
+	stroppy "github.com/stroppy-io/stroppy-core/pkg/proto"
+)
+
+// Keys of JSON entries written by production loggers of logger.NewFromEnv.
+const (
+	entryLevelKey   = "level"
+	entryTimeKey    = "ts"
+	entryMessageKey = "msg"
+
+	pluginLogOutput = "stderr"
+)
+
+// renamedEntryKeys are keys of plugin entries which would clash with keys of the host encoder.
+var renamedEntryKeys = map[string]string{ //nolint: gochecknoglobals // constant map
+	"logger":     "plugin_logger",
+	"caller":     "plugin_caller",
+	"stacktrace": "plugin_stacktrace",
+}
+
+// LogSource identifies a plugin process whose logs are forwarded to the host logger.
+type LogSource struct {
+	// Plugin is the name of the plugin, the base name of Path.
+	Plugin string
+	Path   string
+	RunID  string
+	// Level is the minimum level of forwarded logs, nil forwards all of them.
+	Level *zapcore.Level
+}
+
+// LogSourceFromDriverConfig returns LogSource of the driver plugin of the run.
+func LogSourceFromDriverConfig(runID string, config *stroppy.DriverConfig) *LogSource {
+	return newLogSource(runID, config.GetDriverPluginPath(), config.DriverPluginLogLevel)
+}
+
+// LogSourceFromPlugin returns LogSource of the plugin described in RunConfig.Plugins.
+func LogSourceFromPlugin(runID string, config *stroppy.Plugin) *LogSource {
+	return newLogSource(runID, config.GetPath(), config.LogLevel)
+}
+
+func newLogSource(runID, path string, level *stroppy.LoggerConfig_LogLevel) *LogSource {
+	source := &LogSource{Plugin: filepath.Base(path), Path: path, RunID: runID}
+
+	if level != nil {
+		zapLevel := logger.LevelFromProtoConfig(*level)
+		source.Level = &zapLevel
+	}
+
+	return source
+}
+
+// LoggerConfig returns settings of the logger of the plugin process, they are level and sampling
+// of the run with the level of the source if it is set. Plugins always write production JSON entries
+// to stderr: stdout carries the go-plugin handshake, and LogForwarder parses and attributes only
+// entries it receives, so mode, format and output paths of the run are not used.
+func (s *LogSource) LoggerConfig(config *stroppy.LoggerConfig) *logger.Config {
+	cfg := logger.ConfigFromProto(config)
+	cfg.LogMod = logger.ProductionMod
+	cfg.LogFormat = logger.JSONFormat
+	cfg.OutputPaths = []string{pluginLogOutput}
+
+	if s.Level != nil {
+		cfg.LogLevel = s.Level.String()
+	}
+
+	return cfg
+}
+
+// LogForwarder receives output of a plugin process and re-emits it line by line through the host logger
+// with plugin, plugin_path and run_id fields. JSON entries written by logger.NewFromEnv keep their
+// level, message and fields, other lines are logged at info level as they are.
+// Forwarded entries are also filtered by the level of the host logger,
+// entries above error level are logged as errors so a plugin can not stop the host.
+type LogForwarder struct {
+	mu      sync.Mutex
+	lg      *zap.Logger
+	level   *zapcore.Level
+	partial []byte
+}
+
+// NewLogForwarder creates LogForwarder of one output stream of the plugin described by source.
+func NewLogForwarder(lg *zap.Logger, source *LogSource) *LogForwarder {
+	return &LogForwarder{
+		lg: lg.With(
+			zap.String("plugin", source.Plugin),
+			zap.String("plugin_path", source.Path),
+			zap.String("run_id", source.RunID),
+		),
+		level: source.Level,
+	}
+}
+
+func (f *LogForwarder) Write(data []byte) (int, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.partial = append(f.partial, data...)
+
+	for {
+		idx := bytes.IndexByte(f.partial, '\n')
+		if idx < 0 {
+			break
+		}
+
+		f.forward(f.partial[:idx])
+		f.partial = f.partial[idx+1:]
+	}
+
+	return len(data), nil
+}
+
+func (f *LogForwarder) forward(line []byte) {
+	line = bytes.TrimSpace(line)
+	if len(line) == 0 {
+		return
+	}
+
+	level, msg, fields := parseEntry(line)
+	if f.level != nil && level < *f.level {
+		return
+	}
+
+	if level > zapcore.ErrorLevel {
+		level = zapcore.ErrorLevel
+	}
+
+	if entry := f.lg.Check(level, msg); entry != nil {
+		entry.Write(fields...)
+	}
+}
+
+// parseEntry parses a JSON entry of the plugin logger, a line which is not an entry is returned as info message.
+func parseEntry(line []byte) (zapcore.Level, string, []zap.Field) {
+	var entry map[string]interface{}
+	if json.Unmarshal(line, &entry) != nil {
+		return zapcore.InfoLevel, string(line), nil
+	}
+
+	msg, ok := entry[entryMessageKey].(string)
+	if !ok {
+		return zapcore.InfoLevel, string(line), nil
+	}
+
+	level := zapcore.InfoLevel
+	if name, ok := entry[entryLevelKey].(string); ok {
+		if parsed, err := zapcore.ParseLevel(name); err == nil {
+			level = parsed
+		}
+	}
+
+	delete(entry, entryMessageKey)
+	delete(entry, entryLevelKey)
+	delete(entry, entryTimeKey)
+
+	keys := make([]string, 0, len(entry))
+	for key := range entry {
+		keys = append(keys, key)
+	}
+
+	slices.Sort(keys)
+
+	fields := make([]zap.Field, 0, len(keys))
+
+	for _, key := range keys {
+		name := key
+		if renamed, ok := renamedEntryKeys[key]; ok {
+			name = renamed
+		}
+
+		fields = append(fields, zap.Any(name, entry[key]))
+	}
+
+	return level, msg, fields
+}
@@ -0,0 +1,83 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+
+	"github.com/stroppy-io/stroppy-core/pkg/logger"
+	stroppy "github.com/stroppy-io/stroppy-core/pkg/proto"
+)
+
+func TestLogSourceFromPlugin(t *testing.T) {
+	level := stroppy.LoggerConfig_LOG_LEVEL_WARN
+
+	source := LogSourceFromPlugin("run-1", &stroppy.Plugin{Path: "/opt/plugins/pg-stats", LogLevel: &level})
+	require.Equal(t, "pg-stats", source.Plugin)
+	require.Equal(t, "/opt/plugins/pg-stats", source.Path)
+	require.Equal(t, "run-1", source.RunID)
+	require.Equal(t, zapcore.WarnLevel, *source.Level)
+
+	run := &stroppy.LoggerConfig{
+		LogLevel:    stroppy.LoggerConfig_LOG_LEVEL_DEBUG,
+		LogMode:     stroppy.LoggerConfig_LOG_MODE_DEVELOPMENT,
+		LogFormat:   stroppy.LoggerConfig_LOG_FORMAT_CONSOLE,
+		OutputPaths: []string{"stdout", "/var/log/stroppy.log"},
+	}
+	require.Equal(t, &logger.Config{
+		LogMod:      logger.ProductionMod,
+		LogLevel:    "warn",
+		LogFormat:   logger.JSONFormat,
+		OutputPaths: []string{"stderr"},
+	}, source.LoggerConfig(run))
+
+	source = LogSourceFromDriverConfig("run-1", &stroppy.DriverConfig{DriverPluginPath: "./driver"})
+	require.Equal(t, "driver", source.Plugin)
+	require.Nil(t, source.Level)
+	require.Equal(t, "debug", source.LoggerConfig(run).LogLevel)
+}
+
+func TestLogForwarder(t *testing.T) {
+	core, obs := observer.New(zapcore.DebugLevel)
+	forwarder := NewLogForwarder(zap.New(core), &LogSource{Plugin: "driver", Path: "./driver", RunID: "run-1"})
+
+	_, err := forwarder.Write([]byte(`{"level":"warn","ts":1.5,"logger":"driver","msg":"slow query","ms":120}`))
+	require.NoError(t, err)
+	require.Equal(t, 0, obs.Len(), "unfinished line must wait for newline")
+
+	_, err = forwarder.Write([]byte("\nplain line\n\n{\"level\":\"fatal\",\"msg\":\"giving up\"}\n"))
+	require.NoError(t, err)
+
+	entries := obs.All()
+	require.Len(t, entries, 3)
+
+	require.Equal(t, "slow query", entries[0].Message)
+	require.Equal(t, zapcore.WarnLevel, entries[0].Level)
+	require.Equal(t, map[string]interface{}{
+		"plugin":        "driver",
+		"plugin_path":   "./driver",
+		"run_id":        "run-1",
+		"plugin_logger": "driver",
+		"ms":            float64(120),
+	}, entries[0].ContextMap())
+
+	require.Equal(t, "plain line", entries[1].Message)
+	require.Equal(t, zapcore.InfoLevel, entries[1].Level)
+
+	require.Equal(t, "giving up", entries[2].Message)
+	require.Equal(t, zapcore.ErrorLevel, entries[2].Level)
+}
+
+func TestLogForwarder_Level(t *testing.T) {
+	core, obs := observer.New(zapcore.DebugLevel)
+	level := zapcore.WarnLevel
+	forwarder := NewLogForwarder(zap.New(core), &LogSource{Plugin: "sidecar", Level: &level})
+
+	_, err := forwarder.Write([]byte("{\"level\":\"info\",\"msg\":\"skipped\"}\n{\"level\":\"error\",\"msg\":\"kept\"}\n"))
+	require.NoError(t, err)
+	require.Equal(t, 1, obs.Len())
+	require.Equal(t, "kept", obs.All()[0].Message)
+}
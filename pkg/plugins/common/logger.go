@@ -1,20 +1,48 @@
 package common
 
 import (
+	"fmt"
 	"io"
 	"log"
+	"sync/atomic"
 
 	"github.com/hashicorp/go-hclog"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 )
 
+// extraValueKey is the key of a trailing value without a pair, as hclog names it.
+const extraValueKey = "EXTRA_VALUE_AT_END"
+
+// traceKey marks trace messages, zap has no level below debug so they are logged at debug.
+const traceKey = "trace"
+
+// Logger adapts zap.Logger to hclog.Logger used by go-plugin.
+// Levels set by SetLevel are shared with loggers derived by With and Named,
+// they filter messages in addition to the level of the delegate and may be lowered back.
 type Logger struct {
 	delegate *zap.Logger
+	level    *atomic.Int32
+	implied  []interface{}
+	muted    string
 }
 
 func NewLogger(delegate *zap.Logger) *Logger {
-	return &Logger{delegate: delegate}
+	return &Logger{delegate: delegate, level: &atomic.Int32{}}
+}
+
+func (l *Logger) derive(delegate *zap.Logger, implied []interface{}) *Logger {
+	return &Logger{delegate: delegate, level: l.level, implied: implied, muted: l.muted}
+}
+
+// Mute returns the logger discarding messages of loggers derived by Named(name).
+// go-plugin logs output of a plugin process through Named with the base name of the binary,
+// it is muted when the output is forwarded by LogForwarder instead.
+func (l *Logger) Mute(name string) *Logger {
+	muted := l.derive(l.delegate, l.implied)
+	muted.muted = name
+
+	return muted
 }
 
 func (l *Logger) convertLevel(level hclog.Level) zapcore.Level {
@@ -49,8 +77,73 @@ func (l *Logger) convertLogLevel(level zapcore.Level) hclog.Level {
 	}
 }
 
+// convertArgs converts alternating hclog key/value args to zap fields.
+func (l *Logger) convertArgs(args []interface{}) []zap.Field {
+	fields := make([]zap.Field, 0, (len(args)+1)/2) //nolint: mnd // key/value pairs
+
+	for idx := 0; idx < len(args); idx += 2 {
+		if idx+1 == len(args) {
+			fields = append(fields, convertValue(extraValueKey, args[idx]))
+
+			break
+		}
+
+		key, ok := args[idx].(string)
+		if !ok {
+			key = fmt.Sprint(args[idx])
+		}
+
+		fields = append(fields, convertValue(key, args[idx+1]))
+	}
+
+	return fields
+}
+
+func convertValue(key string, value interface{}) zap.Field {
+	switch typed := value.(type) {
+	case hclog.Format:
+		if len(typed) == 0 {
+			return zap.String(key, "")
+		}
+
+		return zap.String(key, fmt.Sprintf(fmt.Sprint(typed[0]), typed[1:]...))
+	case hclog.Hex:
+		return zap.String(key, fmt.Sprintf("0x%x", int(typed)))
+	case hclog.Octal:
+		return zap.String(key, fmt.Sprintf("0%o", int(typed)))
+	case hclog.Binary:
+		return zap.String(key, fmt.Sprintf("0b%b", int(typed)))
+	case hclog.Quote:
+		return zap.String(key, fmt.Sprintf("%q", string(typed)))
+	default:
+		return zap.Any(key, value)
+	}
+}
+
+// threshold returns the level set by SetLevel or the level of the delegate if it was not set.
+func (l *Logger) threshold() hclog.Level {
+	if level := hclog.Level(l.level.Load()); level != hclog.NoLevel {
+		return level
+	}
+
+	return l.convertLogLevel(l.delegate.Level())
+}
+
+func (l *Logger) enabled(level hclog.Level) bool {
+	return level >= l.threshold() && l.delegate.Core().Enabled(l.convertLevel(level))
+}
+
 func (l *Logger) Log(level hclog.Level, msg string, args ...interface{}) {
-	l.delegate.Log(l.convertLevel(level), msg, zap.Any("_info", args))
+	if level == hclog.Off || !l.enabled(level) {
+		return
+	}
+
+	fields := l.convertArgs(args)
+	if level == hclog.Trace {
+		fields = append(fields, zap.Bool(traceKey, true))
+	}
+
+	l.delegate.Log(l.convertLevel(level), msg, fields...)
 }
 
 func (l *Logger) Trace(msg string, args ...interface{}) {
@@ -74,33 +167,35 @@ func (l *Logger) Error(msg string, args ...interface{}) {
 }
 
 func (l *Logger) IsTrace() bool {
-	return l.delegate.Core().Enabled(zapcore.DebugLevel)
+	return l.enabled(hclog.Trace)
 }
 
 func (l *Logger) IsDebug() bool {
-	return l.delegate.Core().Enabled(zapcore.DebugLevel)
+	return l.enabled(hclog.Debug)
 }
 
 func (l *Logger) IsInfo() bool {
-	return l.delegate.Core().Enabled(zapcore.InfoLevel)
+	return l.enabled(hclog.Info)
 }
 
 func (l *Logger) IsWarn() bool {
-	return l.delegate.Core().Enabled(zapcore.WarnLevel)
+	return l.enabled(hclog.Warn)
 }
 
 func (l *Logger) IsError() bool {
-	return l.delegate.Core().Enabled(zapcore.ErrorLevel)
+	return l.enabled(hclog.Error)
 }
 
+// ImpliedArgs returns args given to With of this logger and its parents.
 func (l *Logger) ImpliedArgs() []interface{} {
-	return nil
+	return l.implied
 }
 
 func (l *Logger) With(args ...interface{}) hclog.Logger { //nolint: ireturn // need from lib
-	return &Logger{
-		delegate: l.delegate.Sugar().With(args...).Desugar(),
-	}
+	implied := make([]interface{}, 0, len(l.implied)+len(args))
+	implied = append(append(implied, l.implied...), args...)
+
+	return l.derive(l.delegate.With(l.convertArgs(args)...), implied)
 }
 
 func (l *Logger) Name() string {
@@ -108,19 +203,25 @@ func (l *Logger) Name() string {
 }
 
 func (l *Logger) Named(name string) hclog.Logger { //nolint: ireturn // need from lib
-	return &Logger{delegate: l.delegate.Named(name)}
+	if l.muted != "" && name == l.muted {
+		return l.derive(zap.NewNop(), l.implied)
+	}
+
+	return l.derive(l.delegate.Named(name), l.implied)
 }
 
 func (l *Logger) ResetNamed(name string) hclog.Logger { //nolint: ireturn // need from lib
-	return &Logger{delegate: l.delegate.Named(name)}
+	return l.derive(l.delegate.Named(name), l.implied)
 }
 
+// SetLevel filters messages below level, hclog.NoLevel restores the level of the delegate.
+// The delegate still drops messages below its own level.
 func (l *Logger) SetLevel(level hclog.Level) {
-	l.delegate = l.delegate.WithOptions(zap.IncreaseLevel(l.convertLevel(level)))
+	l.level.Store(int32(level))
 }
 
 func (l *Logger) GetLevel() hclog.Level { //nolint: ireturn // need from libLevel
-	return l.convertLogLevel(l.delegate.Level())
+	return l.threshold()
 }
 
 func (l *Logger) StandardLogger(_ *hclog.StandardLoggerOptions) *log.Logger {
@@ -1,6 +1,7 @@
 package common
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/hashicorp/go-hclog"
@@ -10,6 +11,8 @@ import (
 	"go.uber.org/zap/zaptest/observer"
 )
 
+var errTest = errors.New("test error")
+
 func TestNewLogger(t *testing.T) {
 	delegate := zap.NewNop()
 	logger := NewLogger(delegate)
@@ -74,6 +77,31 @@ func TestLogger_Log(t *testing.T) {
 	logEntry := obs.All()[0]
 	require.Equal(t, "test message", logEntry.Message)
 	require.Equal(t, zapcore.InfoLevel, logEntry.Level)
+	require.Equal(t, map[string]interface{}{"key": "value"}, logEntry.ContextMap())
+}
+
+func TestLogger_convertArgs(t *testing.T) {
+	core, obs := observer.New(zapcore.InfoLevel)
+	logger := NewLogger(zap.New(core))
+
+	logger.Info("converted",
+		"count", 3,
+		"error", errTest,
+		"formatted", hclog.Fmt("%d-%s", 1, "a"),
+		"hex", hclog.Hex(255),
+		42, "non-string key",
+		"dangling",
+	)
+
+	require.Equal(t, 1, obs.Len())
+	require.Equal(t, map[string]interface{}{
+		"count":       int64(3),
+		"error":       errTest.Error(),
+		"formatted":   "1-a",
+		"hex":         "0xff",
+		"42":          "non-string key",
+		extraValueKey: "dangling",
+	}, obs.All()[0].ContextMap())
 }
 
 func TestLogger_Trace(t *testing.T) {
@@ -81,12 +109,17 @@ func TestLogger_Trace(t *testing.T) {
 	delegate := zap.New(core)
 	logger := NewLogger(delegate)
 
+	logger.Trace("skipped message", "key", "value")
+	require.Equal(t, 0, obs.Len())
+
+	logger.SetLevel(hclog.Trace)
 	logger.Trace("trace message", "key", "value")
 
 	require.Equal(t, 1, obs.Len())
 	logEntry := obs.All()[0]
 	require.Equal(t, "trace message", logEntry.Message)
 	require.Equal(t, zapcore.DebugLevel, logEntry.Level)
+	require.Equal(t, map[string]interface{}{"key": "value", "trace": true}, logEntry.ContextMap())
 }
 
 func TestLogger_Debug(t *testing.T) {
@@ -146,12 +179,15 @@ func TestLogger_IsTrace(t *testing.T) {
 	delegate := zap.New(core)
 	logger := NewLogger(delegate)
 
-	result := logger.IsTrace()
-	require.True(t, result)
+	require.False(t, logger.IsTrace())
+
+	logger.SetLevel(hclog.Trace)
+	require.True(t, logger.IsTrace())
 
 	core2, _ := observer.New(zapcore.InfoLevel)
 	delegate2 := zap.New(core2)
 	logger2 := NewLogger(delegate2)
+	logger2.SetLevel(hclog.Trace)
 
 	result2 := logger2.IsTrace()
 	require.False(t, result2)
@@ -226,6 +262,10 @@ func TestLogger_ImpliedArgs(t *testing.T) {
 
 	result := logger.ImpliedArgs()
 	require.Nil(t, result)
+
+	child := logger.With("plugin", "driver").Named("child").With("attempt", 1)
+	require.Equal(t, []interface{}{"plugin", "driver", "attempt", 1}, child.ImpliedArgs())
+	require.Nil(t, logger.ImpliedArgs())
 }
 
 func TestLogger_With(t *testing.T) {
@@ -239,6 +279,7 @@ func TestLogger_With(t *testing.T) {
 
 	newLogger.Info("test message")
 	require.Equal(t, 1, obs.Len())
+	require.Equal(t, map[string]interface{}{"key": "value"}, obs.All()[0].ContextMap())
 }
 
 func TestLogger_Name(t *testing.T) {
@@ -259,6 +300,18 @@ func TestLogger_Named(t *testing.T) {
 	require.Equal(t, "child-logger", newLogger.Name())
 }
 
+func TestLogger_Mute(t *testing.T) {
+	core, obs := observer.New(zapcore.InfoLevel)
+	logger := NewLogger(zap.New(core)).Mute("driver")
+
+	logger.Named("driver").Info("forwarded elsewhere")
+	require.Equal(t, 0, obs.Len())
+
+	logger.Named("other").Info("kept")
+	logger.Info("kept")
+	require.Equal(t, 2, obs.Len())
+}
+
 func TestLogger_ResetNamed(t *testing.T) {
 	delegate := zap.NewNop().Named("original")
 	logger := NewLogger(delegate)
@@ -273,14 +326,29 @@ func TestLogger_SetLevel(t *testing.T) {
 	core, obs := observer.New(zapcore.DebugLevel)
 	delegate := zap.New(core)
 	logger := NewLogger(delegate)
+	child := logger.Named("child")
 
 	logger.SetLevel(hclog.Info)
 
 	logger.Debug("debug message")
+	child.Debug("debug message")
 	require.Equal(t, 0, obs.Len())
 
 	logger.Info("info message")
 	require.Equal(t, 1, obs.Len())
+
+	logger.SetLevel(hclog.Debug)
+	child.Debug("debug message")
+	require.Equal(t, 2, obs.Len())
+	require.Equal(t, hclog.Debug, logger.GetLevel())
+
+	logger.SetLevel(hclog.Off)
+	logger.Error("error message")
+	require.Equal(t, 2, obs.Len())
+
+	logger.SetLevel(hclog.NoLevel)
+	logger.Error("error message")
+	require.Equal(t, 3, obs.Len())
 }
 
 func TestLogger_GetLevel(t *testing.T) {
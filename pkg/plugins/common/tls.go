@@ -0,0 +1,91 @@
+package common
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+
+	stroppy "github.com/stroppy-io/stroppy-core/pkg/proto"
+)
+
+var ErrInvalidCA = errors.New("no certificates found in CA file")
+
+// ClientTLSConfig returns TLS config of a client verifying the server with CA
+// and presenting the certificate if it is set.
+func ClientTLSConfig(config *stroppy.TlsConfig) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: config.GetServerName(),
+	}
+
+	pool, err := loadCertPool(config.GetCaFile())
+	if err != nil {
+		return nil, err
+	}
+
+	tlsConfig.RootCAs = pool
+
+	tlsConfig.Certificates, err = loadCertificates(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return tlsConfig, nil
+}
+
+// ServerTLSConfig returns TLS config of a server presenting the certificate.
+// Client certificates signed by CA are required if it is set.
+func ServerTLSConfig(config *stroppy.TlsConfig) (*tls.Config, error) {
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+
+	pool, err := loadCertPool(config.GetCaFile())
+	if err != nil {
+		return nil, err
+	}
+
+	if pool != nil {
+		tlsConfig.ClientCAs = pool
+		tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+
+	tlsConfig.Certificates, err = loadCertificates(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return tlsConfig, nil
+}
+
+// loadCertPool returns pool of CA certificates from path, nil if path is empty.
+func loadCertPool(path string) (*x509.CertPool, error) {
+	if path == "" {
+		return nil, nil //nolint: nilnil // system pool is used
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CA file: %w", err)
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(data) {
+		return nil, fmt.Errorf("%w: '%s'", ErrInvalidCA, path)
+	}
+
+	return pool, nil
+}
+
+func loadCertificates(config *stroppy.TlsConfig) ([]tls.Certificate, error) {
+	if config.GetCertFile() == "" {
+		return nil, nil
+	}
+
+	certificate, err := tls.LoadX509KeyPair(config.GetCertFile(), config.GetKeyFile())
+	if err != nil {
+		return nil, fmt.Errorf("failed to load certificate: %w", err)
+	}
+
+	return []tls.Certificate{certificate}, nil
+}
@@ -0,0 +1,79 @@
+package driver
+
+import (
+	"context"
+	"slices"
+
+	stroppy "github.com/stroppy-io/stroppy-core/pkg/proto"
+)
+
+// CapabilitiesProvider may be implemented by Plugin to report its optional features.
+// Plugins which do not implement it are described with DefaultCapabilities.
+type CapabilitiesProvider interface {
+	GetCapabilities(ctx context.Context) (*stroppy.DriverCapabilities, error)
+}
+
+// DefaultCapabilities describes a plugin of the base protocol version:
+// it builds transactions from create table, query and transaction units, with and without streaming.
+func DefaultCapabilities() *stroppy.DriverCapabilities {
+	return &stroppy.DriverCapabilities{
+		ProtocolVersion: pluginVersion,
+		UnitTypes: []stroppy.DriverCapabilities_UnitType{
+			stroppy.DriverCapabilities_UNIT_TYPE_CREATE_TABLE,
+			stroppy.DriverCapabilities_UNIT_TYPE_QUERY,
+			stroppy.DriverCapabilities_UNIT_TYPE_TRANSACTION,
+		},
+		StreamingBuild: true,
+	}
+}
+
+// AllIsolationLevels lists every isolation level, for capabilities of drivers which run all of them.
+func AllIsolationLevels() []stroppy.TxIsolationLevel {
+	return []stroppy.TxIsolationLevel{
+		stroppy.TxIsolationLevel_TX_ISOLATION_LEVEL_READ_UNCOMMITTED,
+		stroppy.TxIsolationLevel_TX_ISOLATION_LEVEL_READ_COMMITTED,
+		stroppy.TxIsolationLevel_TX_ISOLATION_LEVEL_REPEATABLE_READ,
+		stroppy.TxIsolationLevel_TX_ISOLATION_LEVEL_SERIALIZABLE,
+	}
+}
+
+// GetCapabilities returns capabilities of plugin if it implements CapabilitiesProvider
+// and DefaultCapabilities otherwise.
+func GetCapabilities(ctx context.Context, plugin Plugin) (*stroppy.DriverCapabilities, error) {
+	if provider, ok := plugin.(CapabilitiesProvider); ok {
+		return provider.GetCapabilities(ctx)
+	}
+
+	return DefaultCapabilities(), nil
+}
+
+// UnitTypeOf returns the capabilities unit type of unit.
+func UnitTypeOf(unit *stroppy.StepUnitDescriptor) stroppy.DriverCapabilities_UnitType {
+	switch unit.GetType().(type) {
+	case *stroppy.StepUnitDescriptor_CreateTable:
+		return stroppy.DriverCapabilities_UNIT_TYPE_CREATE_TABLE
+	case *stroppy.StepUnitDescriptor_Query:
+		return stroppy.DriverCapabilities_UNIT_TYPE_QUERY
+	case *stroppy.StepUnitDescriptor_Transaction:
+		return stroppy.DriverCapabilities_UNIT_TYPE_TRANSACTION
+	case *stroppy.StepUnitDescriptor_Insert:
+		return stroppy.DriverCapabilities_UNIT_TYPE_INSERT
+	default:
+		return stroppy.DriverCapabilities_UNIT_TYPE_UNSPECIFIED
+	}
+}
+
+// SupportsUnit reports whether the driver can build transactions from unit.
+// An empty list of unit types is treated as "not reported" and supports everything.
+func SupportsUnit(capabilities *stroppy.DriverCapabilities, unit *stroppy.StepUnitDescriptor) bool {
+	return len(capabilities.GetUnitTypes()) == 0 ||
+		slices.Contains(capabilities.GetUnitTypes(), UnitTypeOf(unit))
+}
+
+// SupportsIsolationLevel reports whether the driver can run transactions with level.
+// Unspecified level and an empty list of isolation levels are always supported.
+func SupportsIsolationLevel(capabilities *stroppy.DriverCapabilities, level stroppy.TxIsolationLevel) bool {
+	return level == stroppy.TxIsolationLevel_TX_ISOLATION_LEVEL_UNSPECIFIED ||
+		len(capabilities.GetIsolationLevels()) == 0 ||
+		slices.Contains(capabilities.GetIsolationLevels(), level)
+}
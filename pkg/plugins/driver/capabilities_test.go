@@ -0,0 +1,130 @@
+package driver
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+
+	stroppy "github.com/stroppy-io/stroppy-core/pkg/proto"
+)
+
+type capablePlugin struct {
+	TestPlugin
+	capabilities *stroppy.DriverCapabilities
+}
+
+func (c *capablePlugin) GetCapabilities(context.Context) (*stroppy.DriverCapabilities, error) {
+	return c.capabilities, nil
+}
+
+func dialTestServer(t *testing.T, impl Plugin) stroppy.DriverPluginClient {
+	t.Helper()
+
+	return stroppy.NewDriverPluginClient(dialVersionedTestServer(t, impl, LatestPluginVersion))
+}
+
+// dialVersionedTestServer serves impl speaking the protocol version negotiated with an older host.
+func dialVersionedTestServer(t *testing.T, impl Plugin, version int) *grpc.ClientConn {
+	t.Helper()
+
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer()
+	stroppy.RegisterDriverPluginServer(server, newDriverServer(impl, version))
+
+	go func() {
+		_ = server.Serve(listener)
+	}()
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+			return listener.Dial()
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		conn.Close()
+		server.Stop()
+		listener.Close()
+	})
+
+	return conn
+}
+
+func TestGetCapabilities_Default(t *testing.T) {
+	capabilities, err := GetCapabilities(context.Background(), &TestPlugin{})
+	require.NoError(t, err)
+	require.Equal(t, DefaultCapabilities(), capabilities)
+}
+
+func TestClient_GetCapabilities(t *testing.T) {
+	impl := &capablePlugin{capabilities: &stroppy.DriverCapabilities{
+		DriverName: "test",
+		UnitTypes:  []stroppy.DriverCapabilities_UnitType{stroppy.DriverCapabilities_UNIT_TYPE_INSERT},
+		BatchRun:   true,
+	}}
+
+	tests := []struct {
+		name    string
+		impl    Plugin
+		version int
+		want    string
+	}{
+		{"provider", impl, LatestPluginVersion, "test"},
+		{"not provider", &TestPlugin{}, LatestPluginVersion, ""},
+		{"old protocol", impl, pluginVersion, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			driverClient := newDriverClient(dialTestServer(t, tt.impl))
+			driverClient.version = tt.version
+
+			capabilities, err := driverClient.GetCapabilities(context.Background())
+			require.NoError(t, err)
+			require.Equal(t, tt.want, capabilities.GetDriverName())
+
+			if tt.want != "" {
+				require.Equal(t, uint32(tt.version), capabilities.GetProtocolVersion())
+				require.True(t, capabilities.GetBatchRun())
+			}
+		})
+	}
+}
+
+func TestVersionedPlugins(t *testing.T) {
+	sets := VersionedPlugins(nil)
+	require.Len(t, sets, LatestPluginVersion)
+
+	for version := pluginVersion; version <= LatestPluginVersion; version++ {
+		shared, ok := sets[version][PluginName].(*SharedPlugin)
+		require.True(t, ok)
+		require.Equal(t, version, shared.version)
+	}
+}
+
+func TestSupportsUnit(t *testing.T) {
+	query := &stroppy.StepUnitDescriptor{Type: &stroppy.StepUnitDescriptor_Query{}}
+	insert := &stroppy.StepUnitDescriptor{Type: &stroppy.StepUnitDescriptor_Insert{}}
+
+	require.True(t, SupportsUnit(DefaultCapabilities(), query))
+	require.False(t, SupportsUnit(DefaultCapabilities(), insert))
+	require.True(t, SupportsUnit(&stroppy.DriverCapabilities{}, insert))
+}
+
+func TestSupportsIsolationLevel(t *testing.T) {
+	capabilities := &stroppy.DriverCapabilities{
+		IsolationLevels: []stroppy.TxIsolationLevel{stroppy.TxIsolationLevel_TX_ISOLATION_LEVEL_READ_COMMITTED},
+	}
+
+	require.True(t, SupportsIsolationLevel(capabilities, stroppy.TxIsolationLevel_TX_ISOLATION_LEVEL_UNSPECIFIED))
+	require.True(t, SupportsIsolationLevel(capabilities, stroppy.TxIsolationLevel_TX_ISOLATION_LEVEL_READ_COMMITTED))
+	require.False(t, SupportsIsolationLevel(capabilities, stroppy.TxIsolationLevel_TX_ISOLATION_LEVEL_SERIALIZABLE))
+	require.True(t, SupportsIsolationLevel(DefaultCapabilities(), stroppy.TxIsolationLevel_TX_ISOLATION_LEVEL_SERIALIZABLE))
+}
@@ -4,16 +4,15 @@ import (
 	"context"
 	"errors"
 	"io"
-	"os"
-	"os/exec"
-	"strings"
+	"sync/atomic"
 
-	"github.com/hashicorp/go-plugin"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/stroppy-io/stroppy-core/pkg/logger"
-	"github.com/stroppy-io/stroppy-core/pkg/plugins/common"
 	stroppy "github.com/stroppy-io/stroppy-core/pkg/proto"
 	"github.com/stroppy-io/stroppy-core/pkg/utils/errchan"
 )
@@ -21,6 +20,7 @@ import (
 type client struct {
 	lg          *zap.Logger
 	protoClient stroppy.DriverPluginClient
+	version     int
 }
 
 const driverClientLoggerName = "driver-plugin-client"
@@ -29,6 +29,7 @@ func newDriverClient(protoClient stroppy.DriverPluginClient) *client {
 	return &client{
 		lg:          logger.NewStructLogger(driverClientLoggerName),
 		protoClient: protoClient,
+		version:     LatestPluginVersion,
 	}
 }
 
@@ -38,14 +39,16 @@ func (d *client) Initialize(
 ) error {
 	_, err := d.protoClient.Initialize(ctx, runContext)
 
-	return err
+	return fromStatusError(err)
 }
 
 func (d *client) BuildTransactionsFromUnit(
 	ctx context.Context,
 	buildUnitContext *stroppy.UnitBuildContext,
 ) (*stroppy.DriverTransactionList, error) {
-	return d.protoClient.BuildTransactionsFromUnit(ctx, buildUnitContext)
+	transactions, err := d.protoClient.BuildTransactionsFromUnit(ctx, buildUnitContext)
+
+	return transactions, fromStatusError(err)
 }
 
 func (d *client) BuildTransactionsFromUnitStream(
@@ -54,7 +57,7 @@ func (d *client) BuildTransactionsFromUnitStream(
 ) (errchan.Chan[stroppy.DriverTransaction], error) {
 	stream, err := d.protoClient.BuildTransactionsFromUnitStream(ctx, buildUnitContext)
 	if err != nil {
-		return nil, err
+		return nil, fromStatusError(err)
 	}
 
 	channel := make(errchan.Chan[stroppy.DriverTransaction])
@@ -73,7 +76,7 @@ func (d *client) BuildTransactionsFromUnitStream(
 						return
 					}
 
-					errchan.Send[stroppy.DriverTransaction](channel, nil, err)
+					errchan.Send[stroppy.DriverTransaction](channel, nil, fromStatusError(err))
 
 					return
 				}
@@ -86,57 +89,169 @@ func (d *client) BuildTransactionsFromUnitStream(
 	return channel, nil
 }
 
+// RunTransaction returns the transaction result together with its restored error, see ResultError.
+// Start and end time are measured on the host side if the plugin did not report them.
 func (d *client) RunTransaction(
 	ctx context.Context,
 	transaction *stroppy.DriverTransaction,
-) error {
-	_, err := d.protoClient.RunTransaction(ctx, transaction)
+) (*stroppy.DriverTransactionResult, error) {
+	start := timestamppb.Now()
+
+	result, err := d.protoClient.RunTransaction(ctx, transaction)
+	if err != nil {
+		return nil, fromStatusError(err)
+	}
 
-	return err
+	if result.GetStartTime() == nil {
+		result.StartTime = start
+		result.EndTime = timestamppb.Now()
+	}
+
+	return result, ResultError(result)
+}
+
+// RunTransactions pipelines transactions over a single stream. Plugins of older protocol versions
+// run them with unary RunTransaction calls, timings are measured on the host side then.
+func (d *client) RunTransactions(
+	ctx context.Context,
+	transactions errchan.Chan[stroppy.DriverTransaction],
+) (errchan.Chan[stroppy.DriverTransactionResult], error) {
+	if d.version < batchPluginVersion {
+		return RunTransactionsWith(ctx, d.RunTransaction, transactions), nil
+	}
+
+	stream, err := d.protoClient.RunTransactions(ctx)
+	if err != nil {
+		return nil, fromStatusError(err)
+	}
+
+	var inputErr atomic.Pointer[error]
+
+	go func() {
+		defer stream.CloseSend() //nolint: errcheck // nothing to do with close error
+
+		for {
+			transaction, err := errchan.ReceiveCtx[stroppy.DriverTransaction](ctx, transactions)
+			if errors.Is(err, errchan.ErrReceiveClosed) {
+				return
+			}
+
+			if err != nil {
+				inputErr.Store(&err)
+
+				return
+			}
+
+			if err := stream.Send(transaction); err != nil {
+				return
+			}
+		}
+	}()
+
+	results := make(errchan.Chan[stroppy.DriverTransactionResult])
+
+	go func() {
+		defer errchan.Close[stroppy.DriverTransactionResult](results)
+
+		for {
+			result, err := stream.Recv()
+			if errors.Is(err, io.EOF) {
+				if errPtr := inputErr.Load(); errPtr != nil {
+					_ = errchan.SendCtx[stroppy.DriverTransactionResult](ctx, results, nil, *errPtr)
+				}
+
+				return
+			}
+
+			if errchan.SendCtx(ctx, results, result, fromStatusError(err)) != nil || err != nil {
+				return
+			}
+		}
+	}()
+
+	return results, nil
+}
+
+func (d *client) RunTransactionBatch(
+	ctx context.Context,
+	batch *stroppy.DriverTransactionList,
+) (*stroppy.DriverTransactionResultList, error) {
+	if d.version < batchPluginVersion {
+		return RunTransactionBatchWith(ctx, d.RunTransaction, batch)
+	}
+
+	results, err := d.protoClient.RunTransactionBatch(ctx, batch)
+
+	return results, fromStatusError(err)
 }
 
 func (d *client) Teardown(ctx context.Context) error {
 	_, err := d.protoClient.Teardown(ctx, &emptypb.Empty{})
 
-	return err
+	return fromStatusError(err)
 }
 
+// GetCapabilities asks the plugin for its capabilities when the negotiated protocol supports it,
+// otherwise DefaultCapabilities are returned.
+func (d *client) GetCapabilities(ctx context.Context) (*stroppy.DriverCapabilities, error) {
+	if d.version < capabilitiesPluginVersion {
+		return DefaultCapabilities(), nil
+	}
+
+	capabilities, err := d.protoClient.GetCapabilities(ctx, &emptypb.Empty{})
+	if status.Code(err) == codes.Unimplemented {
+		return DefaultCapabilities(), nil
+	}
+
+	if err != nil {
+		return nil, fromStatusError(err)
+	}
+
+	capabilities.ProtocolVersion = uint32(d.version) //nolint: gosec // small version number
+	capabilities.BatchRun = d.version >= batchPluginVersion
+
+	return capabilities, nil
+}
+
+// ConnectToPlugin connects to the driver of runConfig. Driver registered under driver_name
+// runs in-process, remote driver is connected over TCP, reattach config connects to
+// a pre-started plugin, otherwise the driver plugin binary is started.
+// The plugin process is supervised, see DriverConfig for health checks and restarts.
+// Returned cancel function stops the plugin process.
 func ConnectToPlugin( //nolint: ireturn // need from lib
 	runConfig *stroppy.RunConfig,
 	lg *zap.Logger,
 ) (Plugin, context.CancelFunc, error) {
-	logger.SetLoggerEnv(
-		logger.LevelFromProtoConfig(runConfig.GetLogger().GetLogLevel()),
-		logger.ModeFromProtoConfig(runConfig.GetLogger().GetLogMode()),
-	)
+	if name := runConfig.GetDriver().GetDriverName(); name != "" {
+		local, err := ConnectLocal(name)
+		if err != nil {
+			return nil, func() {}, err
+		}
 
-	clientPlugin := plugin.NewClient(&plugin.ClientConfig{
-		HandshakeConfig: PluginHandshake,
-		Plugins: map[string]plugin.Plugin{
-			PluginName: NewSharedPlugin(nil),
-		},
-		Cmd: exec.Command( //nolint: gosec // allow
-			"sh",
-			"-c",
-			runConfig.GetDriver().GetDriverPluginPath()+" "+
-				strings.Join(runConfig.GetDriver().GetDriverPluginArgs(), " "),
-		),
-		Logger:           common.NewLogger(lg.Named(driverClientLoggerName)),
-		AllowedProtocols: []plugin.Protocol{plugin.ProtocolGRPC},
-		Stderr:           os.Stderr,
-		SyncStderr:       os.Stderr,
-		SyncStdout:       os.Stdout,
-	})
-
-	rpcClient, err := clientPlugin.Client()
-	if err != nil {
-		return nil, clientPlugin.Kill, err
+		return WithRetryPolicy(local, runConfig.GetDriver().GetRetryPolicy()), func() {}, nil
+	}
+
+	config := runConfig.GetDriver()
+
+	start := func() (process, error) {
+		return startPluginProcess(runConfig, lg)
 	}
 
-	raw, err := rpcClient.Dispense(PluginName)
+	if config.GetRemote() != nil {
+		start = func() (process, error) {
+			return dialRemote(config.GetRemote())
+		}
+	}
+
+	supervisor, err := newSupervisedPlugin(
+		lg.Named(driverClientLoggerName),
+		start,
+		config.GetMaxRestarts(),
+		config.GetHealthCheckInterval().AsDuration(),
+	)
 	if err != nil {
-		return nil, clientPlugin.Kill, err
+		return nil, func() {}, err
 	}
 
-	return raw.(Plugin), clientPlugin.Kill, nil //nolint: errcheck,forcetypeassert // allow
+	return WithRetryPolicy(supervisor, config.GetRetryPolicy()), supervisor.Close, nil
 }
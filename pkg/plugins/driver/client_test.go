@@ -18,7 +18,7 @@ func TestNewDriverClient(t *testing.T) {
 
 	server := grpc.NewServer()
 	testPlugin := &TestPlugin{}
-	stroppy.RegisterDriverPluginServer(server, newDriverServer(testPlugin))
+	stroppy.RegisterDriverPluginServer(server, newDriverServer(testPlugin, LatestPluginVersion))
 
 	go func() {
 		if err := server.Serve(listener); err != nil {
@@ -54,7 +54,7 @@ func TestClient_Initialize(t *testing.T) {
 	testPlugin := &TestPlugin{
 		initializeErr: nil,
 	}
-	stroppy.RegisterDriverPluginServer(server, newDriverServer(testPlugin))
+	stroppy.RegisterDriverPluginServer(server, newDriverServer(testPlugin, LatestPluginVersion))
 
 	go func() {
 		if err := server.Serve(listener); err != nil {
@@ -101,7 +101,7 @@ func TestClient_BuildTransactionsFromUnit(t *testing.T) {
 		buildTransactionsResult: expectedTransactions,
 		buildTransactionsErr:    nil,
 	}
-	stroppy.RegisterDriverPluginServer(server, newDriverServer(testPlugin))
+	stroppy.RegisterDriverPluginServer(server, newDriverServer(testPlugin, LatestPluginVersion))
 
 	go func() {
 		if err := server.Serve(listener); err != nil {
@@ -141,7 +141,7 @@ func TestClient_RunTransaction(t *testing.T) {
 	testPlugin := &TestPlugin{
 		runTransactionErr: nil,
 	}
-	stroppy.RegisterDriverPluginServer(server, newDriverServer(testPlugin))
+	stroppy.RegisterDriverPluginServer(server, newDriverServer(testPlugin, LatestPluginVersion))
 
 	go func() {
 		if err := server.Serve(listener); err != nil {
@@ -170,8 +170,9 @@ func TestClient_RunTransaction(t *testing.T) {
 		},
 	}
 
-	err = client.RunTransaction(ctx, transaction)
+	result, err := client.RunTransaction(ctx, transaction)
 	require.NoError(t, err)
+	require.NotNil(t, result.GetStartTime())
 }
 
 func TestClient_Teardown(t *testing.T) {
@@ -182,7 +183,7 @@ func TestClient_Teardown(t *testing.T) {
 	testPlugin := &TestPlugin{
 		teardownErr: nil,
 	}
-	stroppy.RegisterDriverPluginServer(server, newDriverServer(testPlugin))
+	stroppy.RegisterDriverPluginServer(server, newDriverServer(testPlugin, LatestPluginVersion))
 
 	go func() {
 		if err := server.Serve(listener); err != nil {
@@ -0,0 +1,216 @@
+// Package drivertest provides a conformance test suite for driver plugins.
+//
+// Driver authors call Run from a test of their plugin with a database the driver can reach,
+// a local stand-in such as SQLite is enough:
+//
+//	func TestConformance(t *testing.T) {
+//		drivertest.Run(t, drivertest.Config{
+//			New:         func() driver.Plugin { return mydriver.New() },
+//			RunConfig:   &stroppy.RunConfig{Driver: &stroppy.DriverConfig{Url: "file::memory:"}},
+//			Placeholder: queries.PlaceholderQuestion,
+//		})
+//	}
+package drivertest
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/stroppy-io/stroppy-core/pkg/plugins/driver"
+	stroppy "github.com/stroppy-io/stroppy-core/pkg/proto"
+	"github.com/stroppy-io/stroppy-core/pkg/queries"
+)
+
+const (
+	defaultLargeTransactionSize = 1000
+	defaultPingQuery            = "SELECT 1"
+	tablePrefix                 = "drivertest_"
+)
+
+// Config describes the driver under test.
+type Config struct {
+	// New creates the in-process implementation under test, it is run with driver.NewLocal.
+	// If it is nil, the driver is connected with driver.ConnectToPlugin using RunConfig,
+	// e.g. to test the plugin binary.
+	New driver.Factory
+	// RunConfig is passed to the driver in StepContext, its driver url points to the test database.
+	RunConfig *stroppy.RunConfig
+	// Placeholder is the bind parameter style of the driver SQL dialect.
+	Placeholder queries.PlaceholderStyle
+	// ColumnTypes overrides DefaultColumnTypes for the database.
+	ColumnTypes map[stroppy.DriverCapabilities_ValueType]string
+	// PingQuery is a query returning one row without tables, "SELECT 1" if empty.
+	PingQuery string
+	// LargeTransactionSize is the number of queries of the large transaction, 1000 if zero.
+	LargeTransactionSize int
+}
+
+// Run runs the conformance battery against the driver as subtests of t.
+// Tables used by the suite are prefixed with "drivertest_" and dropped before use.
+func Run(t *testing.T, config Config) {
+	t.Helper()
+
+	if config.RunConfig == nil {
+		config.RunConfig = &stroppy.RunConfig{}
+	}
+
+	if config.PingQuery == "" {
+		config.PingQuery = defaultPingQuery
+	}
+
+	if config.LargeTransactionSize == 0 {
+		config.LargeTransactionSize = defaultLargeTransactionSize
+	}
+
+	columnTypes := DefaultColumnTypes()
+	for valueType, columnType := range config.ColumnTypes {
+		columnTypes[valueType] = columnType
+	}
+
+	config.ColumnTypes = columnTypes
+
+	s := &suite{config: config}
+
+	t.Run("Lifecycle", s.testLifecycle)
+	t.Run("Values", s.testValues)
+	t.Run("IsolationLevels", s.testIsolationLevels)
+	t.Run("StreamingCancellation", s.testStreamingCancellation)
+	t.Run("LargeTransaction", s.testLargeTransaction)
+	t.Run("Errors", s.testErrors)
+}
+
+type suite struct {
+	config Config
+}
+
+// open connects to a fresh instance of the driver without initializing it.
+func (s *suite) open(t *testing.T) driver.Plugin { //nolint: ireturn // plugin under test
+	t.Helper()
+
+	if s.config.New != nil {
+		return driver.NewLocal(s.config.New())
+	}
+
+	plugin, cancel, err := driver.ConnectToPlugin(s.config.RunConfig, zap.NewNop())
+	require.NoError(t, err)
+	t.Cleanup(cancel)
+
+	return plugin
+}
+
+// connect opens and initializes the driver, it is torn down when the test ends.
+func (s *suite) connect(t *testing.T) driver.Plugin { //nolint: ireturn // plugin under test
+	t.Helper()
+
+	plugin := s.open(t)
+	require.NoError(t, plugin.Initialize(t.Context(), s.stepContext("drivertest")))
+
+	t.Cleanup(func() {
+		require.NoError(t, plugin.Teardown(context.Background()))
+	})
+
+	return plugin
+}
+
+func (s *suite) stepContext(step string, units ...*stroppy.StepUnitDescriptor) *stroppy.StepContext {
+	return &stroppy.StepContext{
+		Step: &stroppy.StepDescriptor{Name: step, Units: units},
+		GlobalConfig: &stroppy.Config{
+			Version: stroppy.Version,
+			Run:     s.config.RunConfig,
+			Benchmark: &stroppy.BenchmarkDescriptor{
+				Name:  "drivertest",
+				Steps: []*stroppy.StepDescriptor{{Name: step, Units: units}},
+			},
+		},
+	}
+}
+
+func (s *suite) capabilities(t *testing.T, plugin driver.Plugin) *stroppy.DriverCapabilities {
+	t.Helper()
+
+	capabilities, err := driver.GetCapabilities(t.Context(), plugin)
+	require.NoError(t, err)
+
+	return capabilities
+}
+
+// query returns DriverQuery of sql, its "$n" markers are replaced with driver placeholders of params
+// and params are bound with the placeholder style, named params are "pn".
+func (s *suite) query(sql string, params ...*stroppy.Value) *stroppy.DriverQuery {
+	request := sql
+
+	for position := len(params); position > 0; position-- {
+		request = strings.ReplaceAll(
+			request,
+			fmt.Sprintf("$%d", position),
+			s.config.Placeholder.Format(position, bindName(position)),
+		)
+	}
+
+	bound := make([]*stroppy.Value, 0, len(params))
+
+	for idx, param := range params {
+		bound = append(bound, s.config.Placeholder.BindValue(param, bindName(idx+1)))
+	}
+
+	return &stroppy.DriverQuery{Name: strings.Fields(sql)[0], Request: request, Params: bound}
+}
+
+func bindName(position int) string {
+	return fmt.Sprintf("p%d", position)
+}
+
+// exec runs sql in its own transaction and requires it to succeed.
+func (s *suite) exec(
+	t *testing.T,
+	plugin driver.Plugin,
+	sql string,
+	params ...*stroppy.Value,
+) *stroppy.DriverTransactionResult {
+	t.Helper()
+
+	result, err := plugin.RunTransaction(t.Context(), &stroppy.DriverTransaction{
+		Queries: []*stroppy.DriverQuery{s.query(sql, params...)},
+	})
+	require.NoError(t, err, sql)
+	require.Len(t, result.GetQueries(), 1, "query results of %s", sql)
+
+	return result
+}
+
+// createTable drops and creates table with columns, it is dropped when the test ends.
+func (s *suite) createTable(t *testing.T, plugin driver.Plugin, name, columns string) string {
+	t.Helper()
+
+	table := tablePrefix + name
+	s.exec(t, plugin, "DROP TABLE IF EXISTS "+table)
+	s.exec(t, plugin, fmt.Sprintf("CREATE TABLE %s (%s)", table, columns))
+
+	t.Cleanup(func() {
+		_, _ = plugin.RunTransaction(context.Background(), &stroppy.DriverTransaction{
+			Queries: []*stroppy.DriverQuery{s.query("DROP TABLE IF EXISTS " + table)},
+		})
+	})
+
+	return table
+}
+
+func supportsValueType(capabilities *stroppy.DriverCapabilities, valueType stroppy.DriverCapabilities_ValueType) bool {
+	if len(capabilities.GetValueTypes()) == 0 {
+		return true
+	}
+
+	for _, supported := range capabilities.GetValueTypes() {
+		if supported == valueType {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,273 @@
+package drivertest
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/stroppy-io/stroppy-core/pkg/plugins/driver"
+	stroppy "github.com/stroppy-io/stroppy-core/pkg/proto"
+	"github.com/stroppy-io/stroppy-core/pkg/utils/errchan"
+)
+
+const (
+	streamTimeout       = 10 * time.Second
+	streamBuildCount    = 1_000_000
+	largeBatchDivisor   = 10
+	constraintTableCols = "id BIGINT PRIMARY KEY"
+)
+
+// testLifecycle checks that the driver may be initialized for several steps and torn down.
+func (s *suite) testLifecycle(t *testing.T) {
+	t.Run("TeardownAfterInitialize", func(t *testing.T) {
+		plugin := s.open(t)
+
+		require.NoError(t, plugin.Initialize(t.Context(), s.stepContext("first")))
+		require.NoError(t, plugin.Teardown(t.Context()))
+	})
+
+	t.Run("Steps", func(t *testing.T) {
+		plugin := s.open(t)
+
+		for _, step := range []string{"first", "second"} {
+			require.NoError(t, plugin.Initialize(t.Context(), s.stepContext(step)), step)
+
+			result := s.exec(t, plugin, s.config.PingQuery)
+			require.Equal(t, uint64(1), result.GetQueries()[0].GetRowsReturned(), step)
+		}
+
+		require.NoError(t, plugin.Teardown(t.Context()))
+	})
+}
+
+// testValues checks that values of every supported type are bound as params and read back equal.
+func (s *suite) testValues(t *testing.T) {
+	plugin := s.connect(t)
+	capabilities := s.capabilities(t, plugin)
+
+	samples := valueSamples()
+	valueTypes := make([]stroppy.DriverCapabilities_ValueType, 0, len(samples))
+
+	for valueType := range samples {
+		valueTypes = append(valueTypes, valueType)
+	}
+
+	slices.Sort(valueTypes)
+
+	for _, valueType := range valueTypes {
+		t.Run(valueType.String(), func(t *testing.T) {
+			if !supportsValueType(capabilities, valueType) {
+				t.Skip("value type is not supported by the driver")
+			}
+
+			table := s.createTable(t, plugin, "values", "v "+s.config.ColumnTypes[valueType])
+
+			inserted := s.exec(t, plugin, fmt.Sprintf("INSERT INTO %s (v) VALUES ($1)", table), samples[valueType])
+			require.Equal(t, uint64(1), inserted.GetQueries()[0].GetRowsAffected())
+
+			selected := s.exec(t, plugin, fmt.Sprintf("SELECT v FROM %s WHERE v = $1", table), samples[valueType])
+			require.Equal(t, uint64(1), selected.GetQueries()[0].GetRowsReturned())
+		})
+	}
+
+	t.Run(stroppy.DriverCapabilities_VALUE_TYPE_NULL.String(), func(t *testing.T) {
+		if !supportsValueType(capabilities, stroppy.DriverCapabilities_VALUE_TYPE_NULL) {
+			t.Skip("value type is not supported by the driver")
+		}
+
+		columnType := s.config.ColumnTypes[stroppy.DriverCapabilities_VALUE_TYPE_INT64]
+		table := s.createTable(t, plugin, "values", "v "+columnType)
+
+		s.exec(t, plugin, fmt.Sprintf("INSERT INTO %s (v) VALUES ($1)", table), nullValue())
+
+		selected := s.exec(t, plugin, fmt.Sprintf("SELECT v FROM %s WHERE v IS NULL", table))
+		require.Equal(t, uint64(1), selected.GetQueries()[0].GetRowsReturned())
+	})
+}
+
+// testIsolationLevels runs a transaction at every isolation level the driver declares.
+func (s *suite) testIsolationLevels(t *testing.T) {
+	plugin := s.connect(t)
+	capabilities := s.capabilities(t, plugin)
+
+	for _, level := range slices.Sorted(maps.Keys(stroppy.TxIsolationLevel_name)) {
+		isolationLevel := stroppy.TxIsolationLevel(level)
+
+		t.Run(isolationLevel.String(), func(t *testing.T) {
+			if !driver.SupportsIsolationLevel(capabilities, isolationLevel) {
+				t.Skip("isolation level is not supported by the driver")
+			}
+
+			result, err := plugin.RunTransaction(t.Context(), &stroppy.DriverTransaction{
+				IsolationLevel: isolationLevel,
+				Queries:        []*stroppy.DriverQuery{s.query(s.config.PingQuery), s.query(s.config.PingQuery)},
+			})
+			require.NoError(t, err)
+			require.Len(t, result.GetQueries(), 2)
+		})
+	}
+}
+
+// drain receives from channel until it is closed and returns the number of values, it fails
+// the test if the channel is not closed in streamTimeout.
+func drain[T any](t *testing.T, channel errchan.Chan[T]) int {
+	t.Helper()
+
+	timeout := time.After(streamTimeout)
+	received := 0
+
+	for {
+		select {
+		case result, ok := <-channel:
+			if !ok {
+				return received
+			}
+
+			if result.Error == nil {
+				received++
+			}
+		case <-timeout:
+			require.FailNow(t, "stream is not closed after context cancellation")
+		}
+	}
+}
+
+// testStreamingCancellation checks that streams stop when their context is canceled.
+func (s *suite) testStreamingCancellation(t *testing.T) {
+	plugin := s.connect(t)
+	capabilities := s.capabilities(t, plugin)
+
+	t.Run("RunTransactions", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(t.Context())
+		defer cancel()
+
+		// Input is never closed, the stream may end only by cancellation.
+		transactions := make(errchan.Chan[stroppy.DriverTransaction], 1)
+		errchan.Send(transactions, &stroppy.DriverTransaction{
+			Queries: []*stroppy.DriverQuery{s.query(s.config.PingQuery)},
+		}, nil)
+
+		results, err := plugin.RunTransactions(ctx, transactions)
+		require.NoError(t, err)
+
+		result, err := errchan.ReceiveCtx[stroppy.DriverTransactionResult](ctx, results)
+		require.NoError(t, err)
+		require.Empty(t, result.GetError())
+
+		cancel()
+		drain(t, results)
+	})
+
+	t.Run("BuildTransactionsFromUnitStream", func(t *testing.T) {
+		unit := &stroppy.StepUnitDescriptor{Type: &stroppy.StepUnitDescriptor_Query{
+			Query: &stroppy.QueryDescriptor{Name: "ping", Sql: s.config.PingQuery, Count: streamBuildCount},
+		}}
+
+		if !driver.SupportsUnit(capabilities, unit) {
+			t.Skip("query units are not supported by the driver")
+		}
+
+		ctx, cancel := context.WithCancel(t.Context())
+		defer cancel()
+
+		transactions, err := plugin.BuildTransactionsFromUnitStream(ctx, &stroppy.UnitBuildContext{
+			Context: s.stepContext("stream", unit),
+			Unit:    unit,
+		})
+		require.NoError(t, err)
+
+		_, err = errchan.ReceiveCtx[stroppy.DriverTransaction](ctx, transactions)
+		require.NoError(t, err)
+
+		cancel()
+		require.Less(t, drain(t, transactions), streamBuildCount-1)
+	})
+}
+
+// testLargeTransaction runs many queries in one transaction and many transactions in one batch.
+func (s *suite) testLargeTransaction(t *testing.T) {
+	plugin := s.connect(t)
+	size := s.config.LargeTransactionSize
+	columnType := s.config.ColumnTypes[stroppy.DriverCapabilities_VALUE_TYPE_INT64]
+
+	t.Run("Transaction", func(t *testing.T) {
+		table := s.createTable(t, plugin, "large", "id "+columnType)
+		transaction := &stroppy.DriverTransaction{Queries: make([]*stroppy.DriverQuery, 0, size)}
+
+		for id := range size {
+			transaction.Queries = append(transaction.Queries,
+				s.query(fmt.Sprintf("INSERT INTO %s (id) VALUES ($1)", table), int64Value(int64(id))))
+		}
+
+		result, err := plugin.RunTransaction(t.Context(), transaction)
+		require.NoError(t, err)
+		require.Len(t, result.GetQueries(), size)
+
+		selected := s.exec(t, plugin, "SELECT id FROM "+table)
+		require.Equal(t, uint64(size), selected.GetQueries()[0].GetRowsReturned()) //nolint: gosec // positive size
+	})
+
+	t.Run("Batch", func(t *testing.T) {
+		table := s.createTable(t, plugin, "batch", "id "+columnType)
+		batch := &stroppy.DriverTransactionList{}
+
+		for id := range size / largeBatchDivisor {
+			batch.Transactions = append(batch.Transactions, &stroppy.DriverTransaction{
+				Queries: []*stroppy.DriverQuery{
+					s.query(fmt.Sprintf("INSERT INTO %s (id) VALUES ($1)", table), int64Value(int64(id))),
+				},
+			})
+		}
+
+		results, err := plugin.RunTransactionBatch(t.Context(), batch)
+		require.NoError(t, err)
+		require.Len(t, results.GetResults(), len(batch.GetTransactions()))
+
+		for idx, result := range results.GetResults() {
+			require.Equal(t, uint64(idx), result.GetId()) //nolint: gosec // index
+			require.Empty(t, result.GetError())
+		}
+	})
+}
+
+// testErrors checks classification of failures.
+func (s *suite) testErrors(t *testing.T) {
+	plugin := s.connect(t)
+
+	t.Run("Constraint", func(t *testing.T) {
+		table := s.createTable(t, plugin, "errors", constraintTableCols)
+		insert := fmt.Sprintf("INSERT INTO %s (id) VALUES ($1)", table)
+
+		s.exec(t, plugin, insert, int64Value(1))
+
+		result, err := plugin.RunTransaction(t.Context(), &stroppy.DriverTransaction{
+			Queries: []*stroppy.DriverQuery{s.query(insert, int64Value(1))},
+		})
+		require.ErrorIs(t, err, driver.ErrConstraint)
+		require.Equal(t, stroppy.DriverErrorKind_DRIVER_ERROR_KIND_CONSTRAINT_VIOLATION, driver.ErrorKindOf(err))
+		require.Equal(t, stroppy.DriverErrorClass_DRIVER_ERROR_CLASS_CONSTRAINT, result.GetErrorClass())
+	})
+
+	t.Run("Syntax", func(t *testing.T) {
+		_, err := plugin.RunTransaction(t.Context(), &stroppy.DriverTransaction{
+			Queries: []*stroppy.DriverQuery{s.query("SELECT FROM WHERE")},
+		})
+		require.Error(t, err)
+		require.NotErrorIs(t, err, driver.ErrRetryable, "invalid query must not be retried")
+	})
+
+	t.Run("Timeout", func(t *testing.T) {
+		ctx, cancel := context.WithDeadline(t.Context(), time.Now().Add(-time.Second))
+		defer cancel()
+
+		_, err := plugin.RunTransaction(ctx, &stroppy.DriverTransaction{
+			Queries: []*stroppy.DriverQuery{s.query(s.config.PingQuery)},
+		})
+		require.Equal(t, stroppy.DriverErrorClass_DRIVER_ERROR_CLASS_TIMEOUT, driver.ErrorClassOf(err), err)
+	})
+}
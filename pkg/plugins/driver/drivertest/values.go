@@ -0,0 +1,60 @@
+package drivertest
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	stroppy "github.com/stroppy-io/stroppy-core/pkg/proto"
+)
+
+// DefaultColumnTypes are SQL column types of value types used when Config.ColumnTypes has no entry.
+// They follow the SQL standard and are accepted by PostgreSQL and SQLite.
+func DefaultColumnTypes() map[stroppy.DriverCapabilities_ValueType]string {
+	return map[stroppy.DriverCapabilities_ValueType]string{
+		stroppy.DriverCapabilities_VALUE_TYPE_INT32:    "INTEGER",
+		stroppy.DriverCapabilities_VALUE_TYPE_UINT32:   "BIGINT",
+		stroppy.DriverCapabilities_VALUE_TYPE_INT64:    "BIGINT",
+		stroppy.DriverCapabilities_VALUE_TYPE_UINT64:   "NUMERIC(20)",
+		stroppy.DriverCapabilities_VALUE_TYPE_FLOAT:    "REAL",
+		stroppy.DriverCapabilities_VALUE_TYPE_DOUBLE:   "DOUBLE PRECISION",
+		stroppy.DriverCapabilities_VALUE_TYPE_STRING:   "VARCHAR(255)",
+		stroppy.DriverCapabilities_VALUE_TYPE_BOOL:     "BOOLEAN",
+		stroppy.DriverCapabilities_VALUE_TYPE_DECIMAL:  "NUMERIC(10, 2)",
+		stroppy.DriverCapabilities_VALUE_TYPE_UUID:     "VARCHAR(36)",
+		stroppy.DriverCapabilities_VALUE_TYPE_DATETIME: "TIMESTAMP",
+	}
+}
+
+// valueSamples returns a param value of every scalar value type, values are exactly representable
+// in the default column types so they may be compared for equality after round-tripping.
+// Null is checked separately, structs and lists are not used as params.
+func valueSamples() map[stroppy.DriverCapabilities_ValueType]*stroppy.Value {
+	return map[stroppy.DriverCapabilities_ValueType]*stroppy.Value{
+		stroppy.DriverCapabilities_VALUE_TYPE_INT32:  {Type: &stroppy.Value_Int32{Int32: -42}},
+		stroppy.DriverCapabilities_VALUE_TYPE_UINT32: {Type: &stroppy.Value_Uint32{Uint32: 4_000_000_000}},
+		stroppy.DriverCapabilities_VALUE_TYPE_INT64:  {Type: &stroppy.Value_Int64{Int64: -1 << 40}},
+		stroppy.DriverCapabilities_VALUE_TYPE_UINT64: {Type: &stroppy.Value_Uint64{Uint64: 1 << 40}},
+		stroppy.DriverCapabilities_VALUE_TYPE_FLOAT:  {Type: &stroppy.Value_Float{Float: 1.5}},
+		stroppy.DriverCapabilities_VALUE_TYPE_DOUBLE: {Type: &stroppy.Value_Double{Double: -2.25}},
+		stroppy.DriverCapabilities_VALUE_TYPE_STRING: {Type: &stroppy.Value_String_{String_: "stroppy 'quoted' ✓"}},
+		stroppy.DriverCapabilities_VALUE_TYPE_BOOL:   {Type: &stroppy.Value_Bool{Bool: true}},
+		stroppy.DriverCapabilities_VALUE_TYPE_DECIMAL: {Type: &stroppy.Value_Decimal{
+			Decimal: &stroppy.Decimal{Value: "12.5"},
+		}},
+		stroppy.DriverCapabilities_VALUE_TYPE_UUID: {Type: &stroppy.Value_Uuid{
+			Uuid: &stroppy.Uuid{Value: "123e4567-e89b-12d3-a456-426614174000"},
+		}},
+		stroppy.DriverCapabilities_VALUE_TYPE_DATETIME: {Type: &stroppy.Value_Datetime{
+			Datetime: &stroppy.DateTime{Value: timestamppb.New(time.Date(2024, 2, 29, 12, 34, 56, 0, time.UTC))},
+		}},
+	}
+}
+
+func nullValue() *stroppy.Value {
+	return &stroppy.Value{Type: &stroppy.Value_Null{Null: stroppy.Value_NULL_VALUE}}
+}
+
+func int64Value(value int64) *stroppy.Value {
+	return &stroppy.Value{Type: &stroppy.Value_Int64{Int64: value}}
+}
@@ -0,0 +1,30 @@
+package drivertest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	stroppy "github.com/stroppy-io/stroppy-core/pkg/proto"
+	"github.com/stroppy-io/stroppy-core/pkg/queries"
+)
+
+func TestValueSamples(t *testing.T) {
+	columnTypes := DefaultColumnTypes()
+
+	for valueType, value := range valueSamples() {
+		require.Contains(t, columnTypes, valueType)
+		require.NoError(t, value.ValidateAll(), valueType)
+	}
+}
+
+func TestSuite_QueryNamedParams(t *testing.T) {
+	param := &stroppy.Value{Type: &stroppy.Value_Int64{Int64: 1}}
+	s := &suite{config: Config{Placeholder: queries.PlaceholderNamed}}
+
+	query := s.query("SELECT $1, $2", param, param)
+	require.Equal(t, "SELECT @p1, @p2", query.GetRequest())
+	require.Equal(t, "p1", query.GetParams()[0].GetKey())
+	require.Equal(t, "p2", query.GetParams()[1].GetKey())
+	require.Equal(t, int64(1), query.GetParams()[1].GetInt64())
+}
@@ -0,0 +1,194 @@
+package driver
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	stroppy "github.com/stroppy-io/stroppy-core/pkg/proto"
+)
+
+// Sentinels of error classes, every *Error matches the one of its class with errors.Is.
+var (
+	ErrRetryable  = errors.New("retryable driver error")
+	ErrAborted    = errors.New("aborted driver error")
+	ErrConstraint = errors.New("constraint driver error")
+	ErrTimeout    = errors.New("timeout driver error")
+	ErrFatal      = errors.New("fatal driver error")
+)
+
+var classErrors = map[stroppy.DriverErrorClass]error{ //nolint: gochecknoglobals // constant mapping
+	stroppy.DriverErrorClass_DRIVER_ERROR_CLASS_RETRYABLE:  ErrRetryable,
+	stroppy.DriverErrorClass_DRIVER_ERROR_CLASS_ABORTED:    ErrAborted,
+	stroppy.DriverErrorClass_DRIVER_ERROR_CLASS_CONSTRAINT: ErrConstraint,
+	stroppy.DriverErrorClass_DRIVER_ERROR_CLASS_TIMEOUT:    ErrTimeout,
+	stroppy.DriverErrorClass_DRIVER_ERROR_CLASS_FATAL:      ErrFatal,
+}
+
+// classCodes are status codes of classified errors, they are seen by hosts which ignore DriverErrorDetails.
+var classCodes = map[stroppy.DriverErrorClass]codes.Code{ //nolint: gochecknoglobals // constant mapping
+	stroppy.DriverErrorClass_DRIVER_ERROR_CLASS_RETRYABLE:  codes.Unavailable,
+	stroppy.DriverErrorClass_DRIVER_ERROR_CLASS_ABORTED:    codes.Aborted,
+	stroppy.DriverErrorClass_DRIVER_ERROR_CLASS_CONSTRAINT: codes.FailedPrecondition,
+	stroppy.DriverErrorClass_DRIVER_ERROR_CLASS_TIMEOUT:    codes.DeadlineExceeded,
+	stroppy.DriverErrorClass_DRIVER_ERROR_CLASS_FATAL:      codes.Internal,
+}
+
+// Error is a driver failure classified by Class and, optionally, Kind.
+// Plugins return it to let the host tell retryable failures from fatal ones,
+// the classification survives the gRPC boundary as status details.
+type Error struct {
+	Class stroppy.DriverErrorClass
+	Kind  stroppy.DriverErrorKind
+	Err   error
+}
+
+// NewError wraps err with class.
+func NewError(class stroppy.DriverErrorClass, err error) error {
+	return &Error{Class: class, Err: err}
+}
+
+// NewTransactionError wraps err of the transaction with kind and the class of the kind.
+func NewTransactionError(kind stroppy.DriverErrorKind, err error) error {
+	return &Error{Class: ClassOfKind(kind), Kind: kind, Err: err}
+}
+
+// Retryable marks err as retryable.
+func Retryable(err error) error {
+	return NewError(stroppy.DriverErrorClass_DRIVER_ERROR_CLASS_RETRYABLE, err)
+}
+
+// Fatal marks err as fatal.
+func Fatal(err error) error {
+	return NewError(stroppy.DriverErrorClass_DRIVER_ERROR_CLASS_FATAL, err)
+}
+
+func (e *Error) Error() string {
+	return e.Err.Error()
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Is reports whether target is the sentinel of the error class,
+// the class of Kind is used when Class is unspecified.
+func (e *Error) Is(target error) bool {
+	classErr, ok := classErrors[e.class()]
+
+	return ok && classErr == target
+}
+
+func (e *Error) class() stroppy.DriverErrorClass {
+	if e.Class != stroppy.DriverErrorClass_DRIVER_ERROR_CLASS_UNSPECIFIED {
+		return e.Class
+	}
+
+	return ClassOfKind(e.Kind)
+}
+
+// ClassOfKind returns the default class of errors of kind.
+func ClassOfKind(kind stroppy.DriverErrorKind) stroppy.DriverErrorClass {
+	switch kind {
+	case stroppy.DriverErrorKind_DRIVER_ERROR_KIND_SERIALIZATION_FAILURE,
+		stroppy.DriverErrorKind_DRIVER_ERROR_KIND_DEADLOCK:
+		return stroppy.DriverErrorClass_DRIVER_ERROR_CLASS_RETRYABLE
+	case stroppy.DriverErrorKind_DRIVER_ERROR_KIND_CONSTRAINT_VIOLATION:
+		return stroppy.DriverErrorClass_DRIVER_ERROR_CLASS_CONSTRAINT
+	case stroppy.DriverErrorKind_DRIVER_ERROR_KIND_TIMEOUT:
+		return stroppy.DriverErrorClass_DRIVER_ERROR_CLASS_TIMEOUT
+	case stroppy.DriverErrorKind_DRIVER_ERROR_KIND_CONNECTION_LOST:
+		return stroppy.DriverErrorClass_DRIVER_ERROR_CLASS_FATAL
+	default:
+		return stroppy.DriverErrorClass_DRIVER_ERROR_CLASS_UNSPECIFIED
+	}
+}
+
+// ErrorClassOf returns class of Error in the chain of err.
+// Context deadline is classified as timeout, other errors are unspecified.
+func ErrorClassOf(err error) stroppy.DriverErrorClass {
+	var driverErr *Error
+	if errors.As(err, &driverErr) {
+		return driverErr.class()
+	}
+
+	if errors.Is(err, context.DeadlineExceeded) {
+		return stroppy.DriverErrorClass_DRIVER_ERROR_CLASS_TIMEOUT
+	}
+
+	return stroppy.DriverErrorClass_DRIVER_ERROR_CLASS_UNSPECIFIED
+}
+
+// ErrorKindOf returns kind of Error in the chain of err.
+// Context deadline is classified as timeout, other errors are unspecified.
+func ErrorKindOf(err error) stroppy.DriverErrorKind {
+	var driverErr *Error
+	if errors.As(err, &driverErr) {
+		return driverErr.Kind
+	}
+
+	if errors.Is(err, context.DeadlineExceeded) {
+		return stroppy.DriverErrorKind_DRIVER_ERROR_KIND_TIMEOUT
+	}
+
+	return stroppy.DriverErrorKind_DRIVER_ERROR_KIND_UNSPECIFIED
+}
+
+// toStatusError encodes classified err as gRPC status with DriverErrorDetails,
+// unclassified errors are returned as is.
+func toStatusError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	class := ErrorClassOf(err)
+	if class == stroppy.DriverErrorClass_DRIVER_ERROR_CLASS_UNSPECIFIED {
+		return err
+	}
+
+	st, detailsErr := status.New(classCodes[class], err.Error()).WithDetails(&stroppy.DriverErrorDetails{
+		Class:   class,
+		Kind:    ErrorKindOf(err),
+		Message: err.Error(),
+	})
+	if detailsErr != nil {
+		return err
+	}
+
+	return st.Err()
+}
+
+// fromStatusError restores Error from DriverErrorDetails of gRPC status err.
+// Deadline exceeded calls are classified as timeout, other errors are returned as is.
+func fromStatusError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+
+	for _, detail := range st.Details() {
+		if details, ok := detail.(*stroppy.DriverErrorDetails); ok {
+			return &Error{
+				Class: details.GetClass(),
+				Kind:  details.GetKind(),
+				Err:   errors.New(details.GetMessage()), //nolint: err113 // error text comes from the plugin
+			}
+		}
+	}
+
+	if st.Code() == codes.DeadlineExceeded {
+		return &Error{
+			Class: stroppy.DriverErrorClass_DRIVER_ERROR_CLASS_TIMEOUT,
+			Kind:  stroppy.DriverErrorKind_DRIVER_ERROR_KIND_TIMEOUT,
+			Err:   err,
+		}
+	}
+
+	return err
+}
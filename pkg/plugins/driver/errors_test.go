@@ -0,0 +1,138 @@
+package driver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	stroppy "github.com/stroppy-io/stroppy-core/pkg/proto"
+)
+
+var (
+	errDeadlock   = errors.New("deadlock detected")
+	errBadConfig  = errors.New("bad config")
+	errConnection = errors.New("connection refused")
+)
+
+func TestErrorKindOf(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want stroppy.DriverErrorKind
+	}{
+		{"nil", nil, stroppy.DriverErrorKind_DRIVER_ERROR_KIND_UNSPECIFIED},
+		{"plain", errDeadlock, stroppy.DriverErrorKind_DRIVER_ERROR_KIND_UNSPECIFIED},
+		{
+			"wrapped transaction error",
+			fmt.Errorf("run: %w", NewTransactionError(stroppy.DriverErrorKind_DRIVER_ERROR_KIND_DEADLOCK, errDeadlock)),
+			stroppy.DriverErrorKind_DRIVER_ERROR_KIND_DEADLOCK,
+		},
+		{"deadline", context.DeadlineExceeded, stroppy.DriverErrorKind_DRIVER_ERROR_KIND_TIMEOUT},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, ErrorKindOf(tt.err))
+		})
+	}
+}
+
+func TestErrorClassOf(t *testing.T) {
+	tests := []struct {
+		name    string
+		err     error
+		want    stroppy.DriverErrorClass
+		wantErr error
+	}{
+		{"plain", errBadConfig, stroppy.DriverErrorClass_DRIVER_ERROR_CLASS_UNSPECIFIED, nil},
+		{"fatal", fmt.Errorf("init: %w", Fatal(errBadConfig)), stroppy.DriverErrorClass_DRIVER_ERROR_CLASS_FATAL, ErrFatal},
+		{
+			"kind",
+			NewTransactionError(stroppy.DriverErrorKind_DRIVER_ERROR_KIND_SERIALIZATION_FAILURE, errDeadlock),
+			stroppy.DriverErrorClass_DRIVER_ERROR_CLASS_RETRYABLE,
+			ErrRetryable,
+		},
+		{
+			"kind without class",
+			&Error{Kind: stroppy.DriverErrorKind_DRIVER_ERROR_KIND_CONSTRAINT_VIOLATION, Err: errDeadlock},
+			stroppy.DriverErrorClass_DRIVER_ERROR_CLASS_CONSTRAINT,
+			ErrConstraint,
+		},
+		{
+			"wrapped kind without class",
+			fmt.Errorf("run: %w", &Error{
+				Kind: stroppy.DriverErrorKind_DRIVER_ERROR_KIND_SERIALIZATION_FAILURE,
+				Err:  errDeadlock,
+			}),
+			stroppy.DriverErrorClass_DRIVER_ERROR_CLASS_RETRYABLE,
+			ErrRetryable,
+		},
+		{"deadline", context.DeadlineExceeded, stroppy.DriverErrorClass_DRIVER_ERROR_CLASS_TIMEOUT, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, ErrorClassOf(tt.err))
+
+			if tt.wantErr != nil {
+				require.ErrorIs(t, tt.err, tt.wantErr)
+				require.NotErrorIs(t, tt.err, ErrAborted)
+			}
+		})
+	}
+}
+
+func TestToStatusError(t *testing.T) {
+	tests := []struct {
+		class stroppy.DriverErrorClass
+		code  codes.Code
+	}{
+		{stroppy.DriverErrorClass_DRIVER_ERROR_CLASS_RETRYABLE, codes.Unavailable},
+		{stroppy.DriverErrorClass_DRIVER_ERROR_CLASS_ABORTED, codes.Aborted},
+		{stroppy.DriverErrorClass_DRIVER_ERROR_CLASS_CONSTRAINT, codes.FailedPrecondition},
+		{stroppy.DriverErrorClass_DRIVER_ERROR_CLASS_TIMEOUT, codes.DeadlineExceeded},
+		{stroppy.DriverErrorClass_DRIVER_ERROR_CLASS_FATAL, codes.Internal},
+	}
+
+	for _, tt := range tests {
+		err := toStatusError(NewError(tt.class, errDeadlock))
+		require.Equal(t, tt.code, status.Code(err), tt.class.String())
+		require.Equal(t, tt.class, ErrorClassOf(fromStatusError(err)))
+	}
+
+	require.Equal(t, errDeadlock, toStatusError(errDeadlock))
+}
+
+func TestClient_ClassifiedErrors(t *testing.T) {
+	testPlugin := &TestPlugin{
+		initializeErr: Fatal(errBadConfig),
+		teardownErr:   errConnection,
+		buildTransactionsErr: NewTransactionError(
+			stroppy.DriverErrorKind_DRIVER_ERROR_KIND_CONNECTION_LOST,
+			errConnection,
+		),
+	}
+	driverClient := newDriverClient(dialTestServer(t, testPlugin))
+	ctx := context.Background()
+
+	err := driverClient.Initialize(ctx, &stroppy.StepContext{})
+	require.ErrorIs(t, err, ErrFatal)
+	require.EqualError(t, err, errBadConfig.Error())
+
+	_, err = driverClient.BuildTransactionsFromUnit(ctx, &stroppy.UnitBuildContext{})
+
+	var driverErr *Error
+
+	require.ErrorAs(t, err, &driverErr)
+	require.Equal(t, stroppy.DriverErrorKind_DRIVER_ERROR_KIND_CONNECTION_LOST, driverErr.Kind)
+	require.ErrorIs(t, err, ErrFatal)
+
+	err = driverClient.Teardown(ctx)
+	require.Error(t, err)
+	require.NotErrorAs(t, err, &driverErr)
+}
@@ -0,0 +1,157 @@
+package driver
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/protobuf/proto"
+
+	stroppy "github.com/stroppy-io/stroppy-core/pkg/proto"
+	"github.com/stroppy-io/stroppy-core/pkg/utils/errchan"
+)
+
+// localPlugin runs impl in-process with the semantics of the gRPC path: requests are copied,
+// calls on done context fail, streams stop on context cancellation and transaction failures
+// are returned in results with restored errors, see client.RunTransaction.
+type localPlugin struct {
+	impl Plugin
+}
+
+// NewLocal wraps impl to behave as a plugin connected over gRPC without starting a process.
+func NewLocal(impl Plugin) Plugin { //nolint: ireturn // same as ConnectToPlugin
+	return &localPlugin{impl: impl}
+}
+
+func (l *localPlugin) Initialize(ctx context.Context, runContext *stroppy.StepContext) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	return l.impl.Initialize(ctx, proto.CloneOf(runContext))
+}
+
+func (l *localPlugin) BuildTransactionsFromUnit(
+	ctx context.Context,
+	buildUnitContext *stroppy.UnitBuildContext,
+) (*stroppy.DriverTransactionList, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	return l.impl.BuildTransactionsFromUnit(ctx, proto.CloneOf(buildUnitContext))
+}
+
+func (l *localPlugin) BuildTransactionsFromUnitStream(
+	ctx context.Context,
+	buildUnitContext *stroppy.UnitBuildContext,
+) (errchan.Chan[stroppy.DriverTransaction], error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	transactions, err := l.impl.BuildTransactionsFromUnitStream(ctx, proto.CloneOf(buildUnitContext))
+	if err != nil {
+		return nil, err
+	}
+
+	return forward(ctx, transactions, nil, nil), nil
+}
+
+func (l *localPlugin) RunTransaction(
+	ctx context.Context,
+	transaction *stroppy.DriverTransaction,
+) (*stroppy.DriverTransactionResult, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	result := RunTransactionTimed(ctx, 0, l.impl.RunTransaction, proto.CloneOf(transaction))
+
+	return result, ResultError(result)
+}
+
+func (l *localPlugin) RunTransactions(
+	ctx context.Context,
+	transactions errchan.Chan[stroppy.DriverTransaction],
+) (errchan.Chan[stroppy.DriverTransactionResult], error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	results, err := l.impl.RunTransactions(ctx, forward(ctx, transactions, proto.CloneOf[*stroppy.DriverTransaction], nil))
+	if err != nil {
+		return nil, err
+	}
+
+	return forward(ctx, results, nil, nil), nil
+}
+
+func (l *localPlugin) RunTransactionBatch(
+	ctx context.Context,
+	batch *stroppy.DriverTransactionList,
+) (*stroppy.DriverTransactionResultList, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	return l.impl.RunTransactionBatch(ctx, proto.CloneOf(batch))
+}
+
+func (l *localPlugin) Teardown(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	return l.impl.Teardown(ctx)
+}
+
+// GetCapabilities reports the latest protocol version, as it is always negotiated in-process.
+func (l *localPlugin) GetCapabilities(ctx context.Context) (*stroppy.DriverCapabilities, error) {
+	capabilities, err := GetCapabilities(ctx, l.impl)
+	if err != nil {
+		return nil, err
+	}
+
+	capabilities = proto.CloneOf(capabilities)
+	capabilities.ProtocolVersion = LatestPluginVersion
+	capabilities.BatchRun = true
+
+	return capabilities, nil
+}
+
+// forward copies values from input to the returned channel until input is closed, an error is sent
+// or ctx is done, like a gRPC stream does. Values are copied with copyValue and errors
+// are replaced with wrapErr results if they are set.
+func forward[T any](
+	ctx context.Context,
+	input errchan.Chan[T],
+	copyValue func(*T) *T,
+	wrapErr func(error) error,
+) errchan.Chan[T] {
+	output := make(errchan.Chan[T])
+
+	go func() {
+		defer errchan.Close[T](output)
+
+		for {
+			value, err := errchan.ReceiveCtx[T](ctx, input)
+			if errors.Is(err, errchan.ErrReceiveClosed) {
+				return
+			}
+
+			if err == nil && copyValue != nil {
+				value = copyValue(value)
+			}
+
+			if err != nil && wrapErr != nil {
+				err = wrapErr(err)
+			}
+
+			if errchan.SendCtx(ctx, output, value, err) != nil || err != nil {
+				return
+			}
+		}
+	}()
+
+	return output
+}
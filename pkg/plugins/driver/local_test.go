@@ -0,0 +1,78 @@
+package driver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	stroppy "github.com/stroppy-io/stroppy-core/pkg/proto"
+	"github.com/stroppy-io/stroppy-core/pkg/utils/errchan"
+)
+
+// registerTest registers driver for the duration of the test.
+func registerTest(t *testing.T, name string, factory Factory) {
+	t.Helper()
+
+	Register(name, factory)
+
+	t.Cleanup(func() {
+		registry.Lock()
+		defer registry.Unlock()
+
+		delete(registry.factories, name)
+	})
+}
+
+func TestRegister(t *testing.T) {
+	registerTest(t, "test-register", func() Plugin { return &TestPlugin{} })
+
+	require.Contains(t, Drivers(), "test-register")
+	require.Panics(t, func() {
+		Register("test-register", func() Plugin { return &TestPlugin{} })
+	})
+
+	_, err := ConnectLocal("missing")
+	require.ErrorIs(t, err, ErrDriverNotRegistered)
+}
+
+func TestConnectToPlugin_Local(t *testing.T) {
+	impl := &TestPlugin{
+		initializeErr:     Fatal(errBadConfig),
+		runTransactionErr: NewTransactionError(stroppy.DriverErrorKind_DRIVER_ERROR_KIND_DEADLOCK, errDeadlock),
+	}
+	registerTest(t, "test-local", func() Plugin { return impl })
+
+	plugin, cancel, err := ConnectToPlugin(&stroppy.RunConfig{
+		Driver: &stroppy.DriverConfig{DriverName: "test-local"},
+	}, zap.NewNop())
+	require.NoError(t, err)
+
+	defer cancel()
+
+	ctx := context.Background()
+
+	require.ErrorIs(t, plugin.Initialize(ctx, &stroppy.StepContext{}), ErrFatal)
+
+	result, err := plugin.RunTransaction(ctx, &stroppy.DriverTransaction{})
+	require.ErrorIs(t, err, ErrRetryable)
+	require.Equal(t, errDeadlock.Error(), result.GetError())
+	require.NotNil(t, result.GetStartTime())
+
+	capabilities, err := GetCapabilities(ctx, plugin)
+	require.NoError(t, err)
+	require.Equal(t, uint32(LatestPluginVersion), capabilities.GetProtocolVersion())
+
+	stream, err := plugin.RunTransactions(ctx, sendAll(namedTransactions("a", "b"), nil))
+	require.NoError(t, err)
+
+	results, err := errchan.Collect(stream)
+	require.NoError(t, err)
+	requireResults(t, results, 0, 1)
+
+	canceled, cancelCtx := context.WithCancel(ctx)
+	cancelCtx()
+
+	require.ErrorIs(t, plugin.Teardown(canceled), context.Canceled)
+}
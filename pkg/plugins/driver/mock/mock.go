@@ -0,0 +1,228 @@
+// Package mock provides a driver which records received transactions instead of running them.
+// It lets a benchmark be dry-run to inspect the SQL and params it would send, and executors
+// and sidecars be tested deterministically: latency and failures of transactions are simulated
+// from DriverConfig.mock seeded with the run seed.
+//
+// The driver is registered as "mock" to run in-process, cmd/stroppy-driver-mock serves it as a plugin.
+// Both render queries with PlaceholderDollar, use New for other placeholder styles.
+package mock
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/stroppy-io/stroppy-core/pkg/plugins/driver"
+	stroppy "github.com/stroppy-io/stroppy-core/pkg/proto"
+	"github.com/stroppy-io/stroppy-core/pkg/queries"
+)
+
+// DriverName is the name of the driver in the driver registry and its capabilities.
+const DriverName = "mock"
+
+const recordFilePerm = 0o644
+
+func init() { //nolint: gochecknoinits // registration of the linked-in driver
+	driver.Register(DriverName, func() driver.Plugin { return New(queries.PlaceholderDollar) })
+}
+
+// Driver records transactions in memory, or appends them to MockDriverConfig.record_file as JSON lines,
+// and answers them with empty results after the simulated latency. It may be used without Initialize,
+// then transactions are recorded in memory and nothing is simulated.
+type Driver struct {
+	*queries.TransactionBuilder
+	driver.SequentialRunner
+
+	mu           sync.Mutex
+	simulator    *simulator
+	recordPath   string
+	recordFile   *os.File
+	transactions []*stroppy.DriverTransaction
+}
+
+func New(style queries.PlaceholderStyle) *Driver {
+	d := &Driver{
+		TransactionBuilder: queries.NewTransactionBuilder(style),
+		simulator:          &simulator{},
+	}
+	d.SequentialRunner = driver.NewSequentialRunner(d.RunTransaction)
+
+	return d
+}
+
+// Initialize applies MockDriverConfig of the step, the simulation is reseeded for every step.
+func (d *Driver) Initialize(_ context.Context, runContext *stroppy.StepContext) error {
+	run := runContext.GetGlobalConfig().GetRun()
+	config := run.GetDriver().GetMock()
+
+	sim, err := newSimulator(run.GetSeed(), config)
+	if err != nil {
+		return err
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.simulator = sim
+
+	if config.GetRecordFile() == d.recordPath {
+		return nil
+	}
+
+	if err := d.closeLocked(); err != nil {
+		return err
+	}
+
+	if config.GetRecordFile() == "" {
+		return nil
+	}
+
+	file, err := os.OpenFile(config.GetRecordFile(), os.O_CREATE|os.O_WRONLY|os.O_APPEND, recordFilePerm)
+	if err != nil {
+		return fmt.Errorf("failed to open record file: %w", err)
+	}
+
+	d.recordPath, d.recordFile = config.GetRecordFile(), file
+
+	return nil
+}
+
+func (d *Driver) closeLocked() error {
+	if d.recordFile == nil {
+		return nil
+	}
+
+	err := d.recordFile.Close()
+	d.recordPath, d.recordFile = "", nil
+
+	if err != nil {
+		return fmt.Errorf("failed to close record file: %w", err)
+	}
+
+	return nil
+}
+
+// Teardown closes the record file, transactions recorded in memory are kept.
+func (d *Driver) Teardown(_ context.Context) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.closeLocked()
+}
+
+// Transactions returns transactions recorded in memory in the order they were received.
+func (d *Driver) Transactions() []*stroppy.DriverTransaction {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return append([]*stroppy.DriverTransaction(nil), d.transactions...)
+}
+
+// Reset forgets transactions recorded in memory.
+func (d *Driver) Reset() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.transactions = nil
+}
+
+func (d *Driver) record(transaction *stroppy.DriverTransaction) (*simulator, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.recordFile == nil {
+		d.transactions = append(d.transactions, proto.CloneOf(transaction))
+
+		return d.simulator, nil
+	}
+
+	line, err := protojson.Marshal(transaction)
+	if err != nil {
+		return nil, fmt.Errorf("failed to record transaction: %w", err)
+	}
+
+	if _, err := d.recordFile.Write(append(line, '\n')); err != nil {
+		return nil, fmt.Errorf("failed to record transaction: %w", err)
+	}
+
+	return d.simulator, nil
+}
+
+func (d *Driver) GetCapabilities(_ context.Context) (*stroppy.DriverCapabilities, error) {
+	return &stroppy.DriverCapabilities{
+		DriverName:      DriverName,
+		ProtocolVersion: driver.LatestPluginVersion,
+		UnitTypes:       d.UnitTypes(),
+		IsolationLevels: driver.AllIsolationLevels(),
+		StreamingBuild:  true,
+		BatchRun:        true,
+		InsertMethods: []stroppy.InsertMethod{
+			stroppy.InsertMethod_INSERT_METHOD_PLAIN_QUERY,
+			stroppy.InsertMethod_INSERT_METHOD_COPY_FROM,
+			stroppy.InsertMethod_INSERT_METHOD_BULK,
+		},
+	}, nil
+}
+
+// RunTransaction records transaction and fails it or returns its empty result after the simulated latency.
+// Query results carry names of the queries, a bulk insert reports all its rows as affected.
+func (d *Driver) RunTransaction(
+	ctx context.Context,
+	transaction *stroppy.DriverTransaction,
+) (*stroppy.DriverTransactionResult, error) {
+	sim, err := d.record(transaction)
+	if err != nil {
+		return nil, err
+	}
+
+	latency, simulatedErr := sim.next()
+	if err := sleep(ctx, latency); err != nil {
+		return nil, err
+	}
+
+	if simulatedErr != nil {
+		return nil, simulatedErr
+	}
+
+	return transactionResult(transaction), nil
+}
+
+func transactionResult(transaction *stroppy.DriverTransaction) *stroppy.DriverTransactionResult {
+	if batch := transaction.GetBulkInsert(); batch != nil {
+		return &stroppy.DriverTransactionResult{Queries: []*stroppy.DriverQueryResult{{
+			Name:         batch.GetName(),
+			RowsAffected: uint64(len(batch.GetRows())),
+		}}}
+	}
+
+	result := &stroppy.DriverTransactionResult{
+		Queries: make([]*stroppy.DriverQueryResult, 0, len(transaction.GetQueries())),
+	}
+
+	for _, query := range transaction.GetQueries() {
+		result.Queries = append(result.Queries, &stroppy.DriverQueryResult{Name: query.GetName()})
+	}
+
+	return result
+}
+
+func sleep(ctx context.Context, latency time.Duration) error {
+	if latency <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(latency)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,165 @@
+package mock
+
+import (
+	"bufio"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/stroppy-io/stroppy-core/pkg/plugins/driver"
+	stroppy "github.com/stroppy-io/stroppy-core/pkg/proto"
+	"github.com/stroppy-io/stroppy-core/pkg/queries"
+)
+
+func stepContext(config *stroppy.MockDriverConfig) *stroppy.StepContext {
+	return &stroppy.StepContext{
+		Step: &stroppy.StepDescriptor{Name: "step"},
+		GlobalConfig: &stroppy.Config{Run: &stroppy.RunConfig{
+			Seed:   7,
+			Driver: &stroppy.DriverConfig{Mock: config},
+		}},
+	}
+}
+
+func queryUnit(count uint64) *stroppy.UnitBuildContext {
+	return &stroppy.UnitBuildContext{
+		Context: stepContext(nil),
+		Unit: &stroppy.StepUnitDescriptor{Type: &stroppy.StepUnitDescriptor_Query{Query: &stroppy.QueryDescriptor{
+			Name:  "select",
+			Sql:   "SELECT * FROM t WHERE id = ${id}",
+			Count: count,
+			Params: []*stroppy.QueryParamDescriptor{{
+				Name: "id",
+				GenerationRule: &stroppy.Generation_Rule{Type: &stroppy.Generation_Rule_Int64Rules{
+					Int64Rules: &stroppy.Generation_Rules_Int64Rule{
+						Range: &stroppy.Generation_Range_Int64Range{Min: 1, Max: 100},
+					},
+				}},
+			}},
+		}}},
+	}
+}
+
+func TestDriver_RecordsInMemory(t *testing.T) {
+	ctx := context.Background()
+	mock := New(queries.PlaceholderQuestion)
+	plugin := driver.NewLocal(mock)
+
+	transactions, err := plugin.BuildTransactionsFromUnit(ctx, queryUnit(3))
+	require.NoError(t, err)
+
+	results, err := plugin.RunTransactionBatch(ctx, transactions)
+	require.NoError(t, err)
+	require.Len(t, results.GetResults(), 3)
+	require.Equal(t, "select", results.GetResults()[0].GetQueries()[0].GetName())
+
+	recorded := mock.Transactions()
+	require.Len(t, recorded, 3)
+	require.Equal(t, "SELECT * FROM t WHERE id = ?", recorded[0].GetQueries()[0].GetRequest())
+	require.Equal(t, transactions.GetTransactions()[2].GetQueries()[0].GetParams()[0].GetInt64(),
+		recorded[2].GetQueries()[0].GetParams()[0].GetInt64())
+
+	mock.Reset()
+	require.Empty(t, mock.Transactions())
+}
+
+func TestDriver_RecordFile(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "transactions.jsonl")
+	mock := New(queries.PlaceholderDollar)
+
+	require.NoError(t, mock.Initialize(ctx, stepContext(&stroppy.MockDriverConfig{RecordFile: path})))
+
+	transactions, err := mock.BuildTransactionsFromUnit(ctx, queryUnit(2))
+	require.NoError(t, err)
+
+	for _, transaction := range transactions.GetTransactions() {
+		_, err := mock.RunTransaction(ctx, transaction)
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, mock.Teardown(ctx))
+	require.Empty(t, mock.Transactions())
+
+	file, err := os.Open(path)
+	require.NoError(t, err)
+
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	lines := 0
+
+	for ; scanner.Scan(); lines++ {
+		recorded := &stroppy.DriverTransaction{}
+		require.NoError(t, protojson.Unmarshal(scanner.Bytes(), recorded))
+		require.Equal(t, "SELECT * FROM t WHERE id = $1", recorded.GetQueries()[0].GetRequest())
+	}
+
+	require.NoError(t, scanner.Err())
+	require.Equal(t, 2, lines)
+}
+
+func TestDriver_SimulatedErrors(t *testing.T) {
+	ctx := context.Background()
+	config := &stroppy.MockDriverConfig{Errors: []*stroppy.MockError{{
+		Probability: 0.5,
+		Class:       stroppy.DriverErrorClass_DRIVER_ERROR_CLASS_RETRYABLE,
+	}}}
+
+	outcomes := func() []bool {
+		mock := New(queries.PlaceholderDollar)
+		require.NoError(t, mock.Initialize(ctx, stepContext(config)))
+
+		failed := make([]bool, 0, 20)
+
+		for range 20 {
+			_, err := mock.RunTransaction(ctx, &stroppy.DriverTransaction{})
+			if err != nil {
+				require.ErrorIs(t, err, ErrSimulated)
+				require.ErrorIs(t, err, driver.ErrRetryable)
+			}
+
+			failed = append(failed, err != nil)
+		}
+
+		return failed
+	}
+
+	first := outcomes()
+	require.Contains(t, first, true)
+	require.Contains(t, first, false)
+	require.Equal(t, first, outcomes(), "outcomes must be deterministic for the seed")
+}
+
+func TestDriver_SimulatedLatency(t *testing.T) {
+	ctx := context.Background()
+	mock := New(queries.PlaceholderDollar)
+
+	require.ErrorIs(t, mock.Initialize(ctx, stepContext(&stroppy.MockDriverConfig{
+		MinLatency: durationpb.New(time.Second),
+		MaxLatency: durationpb.New(time.Millisecond),
+	})), ErrInvalidLatency)
+
+	latency := 20 * time.Millisecond
+	require.NoError(t, mock.Initialize(ctx, stepContext(&stroppy.MockDriverConfig{
+		MinLatency: durationpb.New(latency),
+		MaxLatency: durationpb.New(latency),
+	})))
+
+	start := time.Now()
+	_, err := mock.RunTransaction(ctx, &stroppy.DriverTransaction{})
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, time.Since(start), latency)
+
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+
+	_, err = mock.RunTransaction(canceled, &stroppy.DriverTransaction{})
+	require.ErrorIs(t, err, context.Canceled)
+}
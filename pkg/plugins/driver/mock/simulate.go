@@ -0,0 +1,107 @@
+package mock
+
+import (
+	"errors"
+	"fmt"
+	r "math/rand/v2"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/stroppy-io/stroppy-core/pkg/generate/distribution"
+	"github.com/stroppy-io/stroppy-core/pkg/plugins/driver"
+	stroppy "github.com/stroppy-io/stroppy-core/pkg/proto"
+)
+
+var (
+	ErrSimulated      = errors.New("simulated driver error")
+	ErrInvalidLatency = errors.New("min latency is greater than max latency")
+)
+
+type latencyRange struct {
+	minLatency int64
+	maxLatency int64
+}
+
+func (l latencyRange) GetMin() int64 { return l.minLatency }
+
+func (l latencyRange) GetMax() int64 { return l.maxLatency }
+
+// simulator draws latencies and failures of transactions from MockDriverConfig.
+// It is seeded with the run seed, so a sequential run gets the same outcomes every time.
+// The zero value simulates nothing.
+type simulator struct {
+	mu      sync.Mutex
+	latency distribution.Distribution[int64]
+	prng    *r.Rand
+	errors  []*stroppy.MockError
+}
+
+func newSimulator(seed uint64, config *stroppy.MockDriverConfig) (*simulator, error) {
+	sim := &simulator{
+		prng:   r.New(r.NewPCG(seed, seed)), //nolint: gosec // simulation does not need crypto rand
+		errors: config.GetErrors(),
+	}
+
+	if config.GetMaxLatency() == nil {
+		return sim, nil
+	}
+
+	latencies := latencyRange{
+		minLatency: config.GetMinLatency().AsDuration().Nanoseconds(),
+		maxLatency: config.GetMaxLatency().AsDuration().Nanoseconds(),
+	}
+
+	if latencies.minLatency > latencies.maxLatency {
+		return nil, fmt.Errorf("%w: %s > %s",
+			ErrInvalidLatency, config.GetMinLatency().AsDuration(), config.GetMaxLatency().AsDuration())
+	}
+
+	sim.latency = distribution.NewDistributionGenerator[int64](
+		config.GetLatencyDistribution(), seed, latencies, true, false,
+	)
+
+	return sim, nil
+}
+
+// next returns the latency of the next transaction and its failure, nil if it succeeds.
+func (s *simulator) next() (time.Duration, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var latency time.Duration
+	if s.latency != nil {
+		latency = time.Duration(s.latency.Next())
+	}
+
+	if len(s.errors) == 0 {
+		return latency, nil
+	}
+
+	point := s.prng.Float64()
+
+	for _, simulated := range s.errors {
+		point -= simulated.GetProbability()
+		if point < 0 {
+			return latency, simulatedError(simulated)
+		}
+	}
+
+	return latency, nil
+}
+
+func simulatedError(simulated *stroppy.MockError) error {
+	class := simulated.GetClass()
+
+	message := simulated.GetMessage()
+	if message == "" {
+		message = strings.ToLower(strings.TrimPrefix(class.String(), "DRIVER_ERROR_CLASS_"))
+	}
+
+	err := fmt.Errorf("%w: %s", ErrSimulated, message)
+	if class == stroppy.DriverErrorClass_DRIVER_ERROR_CLASS_UNSPECIFIED {
+		return err
+	}
+
+	return driver.NewError(class, err)
+}
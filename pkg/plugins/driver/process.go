@@ -0,0 +1,129 @@
+package driver
+
+import (
+	"os/exec"
+
+	"github.com/hashicorp/go-plugin"
+	"go.uber.org/zap"
+
+	"github.com/stroppy-io/stroppy-core/pkg/plugins/common"
+	stroppy "github.com/stroppy-io/stroppy-core/pkg/proto"
+)
+
+const stderrTailLines = 20
+
+// process is a running driver plugin watched by supervisedPlugin.
+type process interface {
+	plugin() Plugin
+	// exitError returns common.ExitError if the process has exited, nil otherwise.
+	exitError() error
+	ping() error
+	kill()
+}
+
+// pluginProcess is a driver plugin binary started with go-plugin, or a plugin reattached
+// with DriverConfig.reattach. Reattached plugins are detached instead of killed.
+type pluginProcess struct {
+	client    *plugin.Client
+	rpcClient plugin.ClientProtocol
+	cmd       *exec.Cmd
+	stderr    *common.TailWriter
+	impl      Plugin
+	detach    bool
+}
+
+// startPluginProcess starts the driver plugin of runConfig with logger settings of the run passed in its environment,
+// its output is forwarded to lg, see common.LogForwarder.
+func startPluginProcess(runConfig *stroppy.RunConfig, lg *zap.Logger) (*pluginProcess, error) {
+	proc := &pluginProcess{}
+	config := runConfig.GetDriver()
+	source := common.LogSourceFromDriverConfig(runConfig.GetRunId(), config)
+	clientConfig := &plugin.ClientConfig{
+		HandshakeConfig:  PluginHandshake,
+		VersionedPlugins: VersionedPlugins(nil),
+		Logger:           common.NewLogger(lg.Named(driverClientLoggerName)).Mute(source.Plugin),
+		AllowedProtocols: []plugin.Protocol{plugin.ProtocolGRPC},
+		SyncStderr:       common.NewLogForwarder(lg, source),
+		SyncStdout:       common.NewLogForwarder(lg, source),
+	}
+
+	if config.GetReattach() != nil {
+		reattach, err := reattachConfig(config.GetReattach())
+		if err != nil {
+			return nil, err
+		}
+
+		// go-plugin does not negotiate the plugin set of reattached plugins.
+		clientConfig.Reattach = reattach
+		clientConfig.Plugins = clientConfig.VersionedPlugins[reattach.ProtocolVersion]
+		proc.detach = true
+	} else {
+		command := common.CommandFromDriverConfig(config).WithLogger(source.LoggerConfig(runConfig.GetLogger()))
+		if err := command.ConfigureClient(clientConfig); err != nil {
+			return nil, err
+		}
+
+		proc.cmd = clientConfig.Cmd
+		proc.stderr = common.NewTailWriter(common.NewLogForwarder(lg, source), stderrTailLines)
+		clientConfig.Stderr = proc.stderr
+	}
+
+	proc.client = plugin.NewClient(clientConfig)
+
+	var err error
+
+	proc.rpcClient, err = proc.client.Client()
+	if err != nil {
+		proc.kill()
+
+		return nil, err
+	}
+
+	raw, err := proc.rpcClient.Dispense(PluginName)
+	if err != nil {
+		proc.kill()
+
+		return nil, err
+	}
+
+	proc.impl = raw.(Plugin) //nolint: errcheck,forcetypeassert // allow
+
+	return proc, nil
+}
+
+func (p *pluginProcess) plugin() Plugin { //nolint: ireturn // remote client
+	return p.impl
+}
+
+// exitError reads the state of the command, it is set by go-plugin before the process is reported as exited.
+// Exit code and stderr of reattached plugins are unknown.
+func (p *pluginProcess) exitError() error {
+	if !p.client.Exited() {
+		return nil
+	}
+
+	if p.cmd == nil {
+		return common.NewExitError(nil, nil)
+	}
+
+	return common.NewExitError(p.cmd.ProcessState, p.stderr.Lines())
+}
+
+// ping checks the gRPC health service registered by go-plugin in the plugin.
+func (p *pluginProcess) ping() error {
+	return p.rpcClient.Ping()
+}
+
+// kill stops the plugin, reattached plugin is left running and only its connection is closed,
+// as go-plugin shuts down the plugin server on kill even in reattach mode.
+func (p *pluginProcess) kill() {
+	if !p.detach {
+		p.client.Kill()
+
+		return
+	}
+
+	if grpcClient, ok := p.rpcClient.(*plugin.GRPCClient); ok {
+		_ = grpcClient.Conn.Close()
+	}
+}
@@ -0,0 +1,59 @@
+package driver
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+
+	stroppy "github.com/stroppy-io/stroppy-core/pkg/proto"
+)
+
+// testPluginEnv makes the test binary serve TestPlugin as a driver plugin.
+const testPluginEnv = "STROPPY_TEST_DRIVER_PLUGIN"
+
+func TestMain(m *testing.M) {
+	if os.Getenv(testPluginEnv) != "" {
+		ServePlugin(&TestPlugin{})
+
+		return
+	}
+
+	os.Exit(m.Run())
+}
+
+func TestStartPluginProcess_StdoutOutput(t *testing.T) {
+	runConfig := &stroppy.RunConfig{
+		RunId: "run-1",
+		Driver: &stroppy.DriverConfig{
+			DriverPluginPath: os.Args[0],
+			// The invalid sampling makes the plugin log a warning before the handshake.
+			DriverPluginEnv: map[string]string{testPluginEnv: "1", "LOG_SAMPLING": "often"},
+		},
+		Logger: &stroppy.LoggerConfig{
+			LogLevel:    stroppy.LoggerConfig_LOG_LEVEL_DEBUG,
+			LogMode:     stroppy.LoggerConfig_LOG_MODE_DEVELOPMENT,
+			LogFormat:   stroppy.LoggerConfig_LOG_FORMAT_CONSOLE,
+			OutputPaths: []string{"stdout"},
+		},
+	}
+
+	core, logs := observer.New(zapcore.DebugLevel)
+
+	proc, err := startPluginProcess(runConfig, zap.New(core))
+	require.NoError(t, err)
+
+	defer proc.kill()
+
+	require.NoError(t, proc.plugin().Initialize(context.Background(), &stroppy.StepContext{}))
+
+	require.Eventually(t, func() bool {
+		return logs.FilterMessage("invalid logger environment, defaults are used instead").
+			FilterField(zap.String("run_id", "run-1")).Len() == 1
+	}, 5*time.Second, 10*time.Millisecond)
+}
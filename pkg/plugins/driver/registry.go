@@ -0,0 +1,65 @@
+package driver
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+)
+
+// ErrDriverNotRegistered is returned when no driver is registered under the requested name.
+var ErrDriverNotRegistered = errors.New("driver is not registered")
+
+// Factory creates an instance of a driver linked into the binary.
+type Factory func() Plugin
+
+var registry = struct { //nolint: gochecknoglobals // registry of linked-in drivers
+	sync.RWMutex
+	factories map[string]Factory
+}{factories: make(map[string]Factory)}
+
+// Register makes a driver available to run in-process by name, see DriverConfig.driver_name.
+// It is intended to be called from init of the driver package and panics
+// if the name is empty or already registered.
+func Register(name string, factory Factory) {
+	registry.Lock()
+	defer registry.Unlock()
+
+	if name == "" || factory == nil {
+		panic("driver: register of empty name or nil factory")
+	}
+
+	if _, ok := registry.factories[name]; ok {
+		panic("driver: register called twice for " + name)
+	}
+
+	registry.factories[name] = factory
+}
+
+// Drivers returns sorted names of registered drivers.
+func Drivers() []string {
+	registry.RLock()
+	defer registry.RUnlock()
+
+	names := make([]string, 0, len(registry.factories))
+	for name := range registry.factories {
+		names = append(names, name)
+	}
+
+	slices.Sort(names)
+
+	return names
+}
+
+// ConnectLocal creates registered driver name and wraps it to behave as a plugin connected over gRPC.
+func ConnectLocal(name string) (Plugin, error) { //nolint: ireturn // same as ConnectToPlugin
+	registry.RLock()
+	factory, ok := registry.factories[name]
+	registry.RUnlock()
+
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrDriverNotRegistered, name)
+	}
+
+	return NewLocal(factory()), nil
+}
@@ -0,0 +1,189 @@
+package driver
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/hashicorp/go-plugin"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/stroppy-io/stroppy-core/pkg/logger"
+	"github.com/stroppy-io/stroppy-core/pkg/plugins/common"
+	stroppy "github.com/stroppy-io/stroppy-core/pkg/proto"
+)
+
+const remotePingTimeout = 5 * time.Second
+
+var ErrInsecureRemote = errors.New("remote driver plugin requires TLS unless insecure is set")
+
+// ServeRemote serves impl on listener as a standalone gRPC server with TLS until ctx is done,
+// hosts connect to it with DriverConfig.remote. Use common.ServerTLSConfig to require client certificates.
+// ErrInsecureRemote is returned if tlsConfig is nil, plaintext is served only by ServeRemoteInsecure.
+func ServeRemote(ctx context.Context, impl Plugin, listener net.Listener, tlsConfig *tls.Config) error {
+	if tlsConfig == nil {
+		return ErrInsecureRemote
+	}
+
+	return serveRemote(ctx, impl, listener, grpc.Creds(credentials.NewTLS(tlsConfig)))
+}
+
+// ServeRemoteInsecure serves impl on listener like ServeRemote but in plaintext,
+// hosts connect to it with RemoteDriverConfig.insecure set.
+func ServeRemoteInsecure(ctx context.Context, impl Plugin, listener net.Listener) error {
+	return serveRemote(ctx, impl, listener)
+}
+
+func serveRemote(ctx context.Context, impl Plugin, listener net.Listener, options ...grpc.ServerOption) error {
+	server := grpc.NewServer(options...)
+	healthServer := health.NewServer()
+
+	stroppy.RegisterDriverPluginServer(server, newDriverServer(impl, LatestPluginVersion))
+	healthpb.RegisterHealthServer(server, healthServer)
+
+	stop := context.AfterFunc(ctx, func() {
+		healthServer.Shutdown()
+		server.GracefulStop()
+	})
+	defer stop()
+
+	if err := server.Serve(listener); err != nil {
+		return fmt.Errorf("failed to serve driver plugin: %w", err)
+	}
+
+	return nil
+}
+
+// ServeReattachable serves impl until ctx is done without being started by a host.
+// Config to reattach to the plugin with DriverConfig.reattach is sent to configs once it listens.
+// Hosts detach from the plugin instead of stopping it, so it may be reused across runs.
+func ServeReattachable(ctx context.Context, impl Plugin, configs chan<- *stroppy.ReattachConfig) {
+	reattachConfigs := make(chan *plugin.ReattachConfig, 1)
+
+	go func() {
+		select {
+		case <-ctx.Done():
+		case config := <-reattachConfigs:
+			configs <- &stroppy.ReattachConfig{
+				Network:         config.Addr.Network(),
+				Address:         config.Addr.String(),
+				Pid:             int32(config.Pid),              //nolint: gosec // process id fits
+				ProtocolVersion: uint32(config.ProtocolVersion), //nolint: gosec // small version number
+			}
+		}
+	}()
+
+	plugin.Serve(&plugin.ServeConfig{
+		HandshakeConfig:  PluginHandshake,
+		VersionedPlugins: VersionedPlugins(impl),
+		GRPCServer:       plugin.DefaultGRPCServer,
+		Logger:           common.NewLogger(logger.NewFromEnv()),
+		Test: &plugin.ServeTestConfig{
+			Context:          ctx,
+			ReattachConfigCh: reattachConfigs,
+		},
+	})
+}
+
+// reattachConfig converts config to go-plugin reattach config of a plugin in test mode,
+// which is not killed by the host.
+func reattachConfig(config *stroppy.ReattachConfig) (*plugin.ReattachConfig, error) {
+	var (
+		addr net.Addr
+		err  error
+	)
+
+	if config.GetNetwork() == "unix" {
+		addr, err = net.ResolveUnixAddr(config.GetNetwork(), config.GetAddress())
+	} else {
+		addr, err = net.ResolveTCPAddr(config.GetNetwork(), config.GetAddress())
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("invalid reattach address: %w", err)
+	}
+
+	version := int(config.GetProtocolVersion())
+	if version == 0 {
+		version = LatestPluginVersion
+	}
+
+	return &plugin.ReattachConfig{
+		Protocol:        plugin.ProtocolGRPC,
+		ProtocolVersion: version,
+		Addr:            addr,
+		Pid:             int(config.GetPid()),
+		Test:            true,
+	}, nil
+}
+
+// remoteConnection is a connection to a driver plugin served with ServeRemote.
+// Exit of the remote process can't be observed, it is detected by health checks.
+type remoteConnection struct {
+	conn   *grpc.ClientConn
+	impl   Plugin
+	health healthpb.HealthClient
+}
+
+// dialRemote connects with TLS, plaintext is used only if RemoteDriverConfig.insecure is set without tls.
+func dialRemote(config *stroppy.RemoteDriverConfig) (*remoteConnection, error) {
+	creds := insecure.NewCredentials()
+
+	switch {
+	case config.GetTls() != nil:
+		tlsConfig, err := common.ClientTLSConfig(config.GetTls())
+		if err != nil {
+			return nil, err
+		}
+
+		creds = credentials.NewTLS(tlsConfig)
+	case !config.GetInsecure():
+		return nil, fmt.Errorf("%w: %s", ErrInsecureRemote, config.GetAddress())
+	}
+
+	conn, err := grpc.NewClient(config.GetAddress(), grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to driver plugin at %s: %w", config.GetAddress(), err)
+	}
+
+	return &remoteConnection{
+		conn:   conn,
+		impl:   newDriverClient(stroppy.NewDriverPluginClient(conn)),
+		health: healthpb.NewHealthClient(conn),
+	}, nil
+}
+
+func (r *remoteConnection) plugin() Plugin { //nolint: ireturn // remote client
+	return r.impl
+}
+
+func (r *remoteConnection) exitError() error {
+	return nil
+}
+
+func (r *remoteConnection) ping() error {
+	ctx, cancel := context.WithTimeout(context.Background(), remotePingTimeout)
+	defer cancel()
+
+	response, err := r.health.Check(ctx, &healthpb.HealthCheckRequest{})
+	if err != nil {
+		return err
+	}
+
+	if response.GetStatus() != healthpb.HealthCheckResponse_SERVING {
+		return fmt.Errorf("%w: %s", ErrPluginUnhealthy, response.GetStatus())
+	}
+
+	return nil
+}
+
+func (r *remoteConnection) kill() {
+	_ = r.conn.Close()
+}
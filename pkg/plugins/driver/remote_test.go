@@ -0,0 +1,192 @@
+package driver
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/stroppy-io/stroppy-core/pkg/plugins/common"
+	stroppy "github.com/stroppy-io/stroppy-core/pkg/proto"
+)
+
+type testCertificate struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+// writeCertificate issues certificate signed by parent, self-signed if parent is nil,
+// and writes it with its key to dir as name.crt and name.key.
+func writeCertificate(
+	t *testing.T,
+	dir, name string,
+	template *x509.Certificate,
+	parent *testCertificate,
+) *testCertificate {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template.SerialNumber = big.NewInt(time.Now().UnixNano())
+	template.Subject = pkix.Name{CommonName: name}
+	template.NotBefore = time.Now().Add(-time.Hour)
+	template.NotAfter = time.Now().Add(time.Hour)
+
+	signer := &testCertificate{cert: template, key: key}
+	if parent != nil {
+		signer = parent
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, signer.cert, &key.PublicKey, signer.key)
+	require.NoError(t, err)
+
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certPem := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPem := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name+".crt"), certPem, 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name+".key"), keyPem, 0o600))
+
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return &testCertificate{cert: cert, key: key}
+}
+
+// writeMTLS writes CA, server and client certificates to a temporary directory.
+func writeMTLS(t *testing.T) (server, client *stroppy.TlsConfig) {
+	t.Helper()
+
+	dir := t.TempDir()
+	ca := writeCertificate(t, dir, "ca", &x509.Certificate{
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}, nil)
+	writeCertificate(t, dir, "server", &x509.Certificate{
+		IPAddresses: []net.IP{net.IPv4(127, 0, 0, 1)},
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}, ca)
+	writeCertificate(t, dir, "client", &x509.Certificate{
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}, ca)
+
+	tlsConfig := func(name string) *stroppy.TlsConfig {
+		return &stroppy.TlsConfig{
+			CaFile:   filepath.Join(dir, "ca.crt"),
+			CertFile: filepath.Join(dir, name+".crt"),
+			KeyFile:  filepath.Join(dir, name+".key"),
+		}
+	}
+
+	return tlsConfig("server"), tlsConfig("client")
+}
+
+func TestConnectToPlugin_Remote(t *testing.T) {
+	serverTLS, clientTLS := writeMTLS(t)
+
+	tlsConfig, err := common.ServerTLSConfig(serverTLS)
+	require.NoError(t, err)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	served := make(chan error, 1)
+
+	go func() {
+		served <- ServeRemote(ctx, &TestPlugin{initializeErr: Fatal(errBadConfig)}, listener, tlsConfig)
+	}()
+
+	connect := func(tls *stroppy.TlsConfig) Plugin {
+		plugin, closePlugin, err := ConnectToPlugin(&stroppy.RunConfig{
+			Driver: &stroppy.DriverConfig{
+				Remote: &stroppy.RemoteDriverConfig{Address: listener.Addr().String(), Tls: tls},
+			},
+		}, zap.NewNop())
+		require.NoError(t, err)
+		t.Cleanup(closePlugin)
+
+		return plugin
+	}
+
+	plugin := connect(clientTLS)
+	require.ErrorIs(t, plugin.Initialize(ctx, &stroppy.StepContext{}), ErrFatal)
+
+	capabilities, err := GetCapabilities(ctx, plugin)
+	require.NoError(t, err)
+	require.True(t, capabilities.GetBatchRun())
+
+	withoutCert := connect(&stroppy.TlsConfig{CaFile: clientTLS.GetCaFile()})
+	require.Error(t, withoutCert.Teardown(ctx))
+
+	cancel()
+	require.NoError(t, <-served)
+}
+
+func TestConnectToPlugin_RemoteInsecure(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	served := make(chan error, 1)
+
+	require.ErrorIs(t, ServeRemote(ctx, &TestPlugin{}, listener, nil), ErrInsecureRemote)
+
+	go func() {
+		served <- ServeRemoteInsecure(ctx, &TestPlugin{initializeErr: Fatal(errBadConfig)}, listener)
+	}()
+
+	remote := &stroppy.RemoteDriverConfig{Address: listener.Addr().String()}
+	runConfig := &stroppy.RunConfig{Driver: &stroppy.DriverConfig{Remote: remote}}
+
+	_, _, err = ConnectToPlugin(runConfig, zap.NewNop())
+	require.ErrorIs(t, err, ErrInsecureRemote)
+
+	remote.Insecure = true
+
+	plugin, closePlugin, err := ConnectToPlugin(runConfig, zap.NewNop())
+	require.NoError(t, err)
+	require.ErrorIs(t, plugin.Initialize(ctx, &stroppy.StepContext{}), ErrFatal)
+	closePlugin()
+
+	cancel()
+	require.NoError(t, <-served)
+}
+
+func TestConnectToPlugin_Reattach(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	configs := make(chan *stroppy.ReattachConfig, 1)
+
+	go ServeReattachable(ctx, &TestPlugin{initializeErr: Fatal(errBadConfig)}, configs)
+
+	runConfig := &stroppy.RunConfig{
+		Driver: &stroppy.DriverConfig{Reattach: <-configs},
+	}
+
+	// The plugin outlives hosts, so the second run reuses it.
+	for range 2 {
+		plugin, closePlugin, err := ConnectToPlugin(runConfig, zap.NewNop())
+		require.NoError(t, err)
+
+		require.ErrorIs(t, plugin.Initialize(ctx, &stroppy.StepContext{}), ErrFatal)
+		closePlugin()
+	}
+}
@@ -0,0 +1,33 @@
+package driver
+
+import (
+	"errors"
+
+	stroppy "github.com/stroppy-io/stroppy-core/pkg/proto"
+)
+
+// SetResultError records err of the transaction in result.
+func SetResultError(result *stroppy.DriverTransactionResult, err error) {
+	if err == nil {
+		return
+	}
+
+	result.Error = err.Error()
+	result.ErrorKind = ErrorKindOf(err)
+	result.ErrorClass = ErrorClassOf(err)
+}
+
+// ResultError restores the transaction error recorded in result, nil if it succeeded.
+func ResultError(result *stroppy.DriverTransactionResult) error {
+	if result.GetError() == "" {
+		return nil
+	}
+
+	err := errors.New(result.GetError()) //nolint: err113 // error text comes from the plugin
+	if result.GetErrorKind() == stroppy.DriverErrorKind_DRIVER_ERROR_KIND_UNSPECIFIED &&
+		result.GetErrorClass() == stroppy.DriverErrorClass_DRIVER_ERROR_CLASS_UNSPECIFIED {
+		return err
+	}
+
+	return &Error{Class: result.GetErrorClass(), Kind: result.GetErrorKind(), Err: err}
+}
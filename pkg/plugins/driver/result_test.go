@@ -0,0 +1,71 @@
+package driver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	stroppy "github.com/stroppy-io/stroppy-core/pkg/proto"
+)
+
+func TestClient_RunTransactionResult(t *testing.T) {
+	testPlugin := &TestPlugin{
+		runTransactionResult: &stroppy.DriverTransactionResult{
+			Retries: 2,
+			Queries: []*stroppy.DriverQueryResult{
+				{Name: "update", Duration: durationpb.New(time.Millisecond), RowsAffected: 3},
+			},
+		},
+		runTransactionErr: NewTransactionError(stroppy.DriverErrorKind_DRIVER_ERROR_KIND_DEADLOCK, errDeadlock),
+	}
+	driverClient := newDriverClient(dialTestServer(t, testPlugin))
+
+	result, err := driverClient.RunTransaction(context.Background(), &stroppy.DriverTransaction{})
+
+	var driverErr *Error
+
+	require.ErrorAs(t, err, &driverErr)
+	require.Equal(t, stroppy.DriverErrorKind_DRIVER_ERROR_KIND_DEADLOCK, driverErr.Kind)
+	require.Equal(t, errDeadlock.Error(), err.Error())
+	require.Equal(t, uint32(2), result.GetRetries())
+	require.Equal(t, uint64(3), result.GetQueries()[0].GetRowsAffected())
+	require.Equal(t, time.Millisecond, result.GetQueries()[0].GetDuration().AsDuration())
+	require.NotNil(t, result.GetStartTime())
+}
+
+func TestServer_RunTransactionOlderHost(t *testing.T) {
+	testPlugin := &TestPlugin{
+		runTransactionErr: NewTransactionError(stroppy.DriverErrorKind_DRIVER_ERROR_KIND_DEADLOCK, errDeadlock),
+	}
+
+	for version := pluginVersion; version < resultsPluginVersion; version++ {
+		conn := dialVersionedTestServer(t, testPlugin, version)
+
+		// Hosts before resultsPluginVersion decode the reply of RunTransaction as Empty.
+		err := conn.Invoke(context.Background(), stroppy.DriverPlugin_RunTransaction_FullMethodName,
+			&stroppy.DriverTransaction{}, &emptypb.Empty{})
+		require.Equal(t, codes.Unavailable, status.Code(err), "version %d", version)
+		require.Contains(t, err.Error(), errDeadlock.Error())
+
+		driverClient := newDriverClient(stroppy.NewDriverPluginClient(conn))
+		driverClient.version = version
+
+		_, err = driverClient.RunTransaction(context.Background(), &stroppy.DriverTransaction{})
+
+		var driverErr *Error
+
+		require.ErrorAs(t, err, &driverErr)
+		require.Equal(t, stroppy.DriverErrorKind_DRIVER_ERROR_KIND_DEADLOCK, driverErr.Kind)
+	}
+}
+
+func TestResultError(t *testing.T) {
+	require.NoError(t, ResultError(&stroppy.DriverTransactionResult{}))
+	require.EqualError(t, ResultError(&stroppy.DriverTransactionResult{Error: "boom"}), "boom")
+}
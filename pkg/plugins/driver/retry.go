@@ -0,0 +1,285 @@
+package driver
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand/v2"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	stroppy "github.com/stroppy-io/stroppy-core/pkg/proto"
+	"github.com/stroppy-io/stroppy-core/pkg/utils/errchan"
+)
+
+const (
+	defaultInitialBackoff    = 10 * time.Millisecond
+	defaultMaxBackoff        = time.Second
+	defaultBackoffMultiplier = 2
+)
+
+// Retrier retries failed transactions according to stroppy.RetryPolicy.
+type Retrier struct {
+	policy  *stroppy.RetryPolicy
+	classes map[stroppy.DriverErrorClass]struct{}
+}
+
+// NewRetrier creates Retrier of policy, nil policy disables retries.
+func NewRetrier(policy *stroppy.RetryPolicy) *Retrier {
+	classes := make(map[stroppy.DriverErrorClass]struct{})
+	for _, class := range policy.GetRetryClasses() {
+		classes[class] = struct{}{}
+	}
+
+	if len(classes) == 0 {
+		classes[stroppy.DriverErrorClass_DRIVER_ERROR_CLASS_RETRYABLE] = struct{}{}
+	}
+
+	return &Retrier{policy: policy, classes: classes}
+}
+
+// Enabled reports whether the policy allows more than one attempt.
+func (r *Retrier) Enabled() bool {
+	return r.policy.GetMaxAttempts() > 1
+}
+
+// ShouldRetry reports whether transaction failed with err after attempt attempts should be retried.
+func (r *Retrier) ShouldRetry(err error, attempt uint32) bool {
+	if err == nil || attempt >= r.policy.GetMaxAttempts() {
+		return false
+	}
+
+	_, ok := r.classes[ErrorClassOf(err)]
+
+	return ok
+}
+
+// Backoff returns delay before retry-th retry.
+func (r *Retrier) Backoff(retry uint32) time.Duration {
+	initial, maxBackoff, multiplier := defaultInitialBackoff, defaultMaxBackoff, float64(defaultBackoffMultiplier)
+
+	if backoff := r.policy.GetInitialBackoff(); backoff != nil {
+		initial = backoff.AsDuration()
+	}
+
+	if backoff := r.policy.GetMaxBackoff(); backoff != nil {
+		maxBackoff = backoff.AsDuration()
+	}
+
+	// Multiplier is validated to be at least 1, so zero means unset.
+	if policyMultiplier := r.policy.GetBackoffMultiplier(); policyMultiplier != 0 {
+		multiplier = policyMultiplier
+	}
+
+	delay := math.Min(float64(initial)*math.Pow(multiplier, float64(retry-1)), float64(maxBackoff))
+	delay -= delay * r.policy.GetJitter() * rand.Float64() //nolint: gosec // no need in secure random for jitter
+
+	return time.Duration(delay)
+}
+
+// Wrap returns run that retries failed transactions.
+func (r *Retrier) Wrap(run RunFunc) RunFunc {
+	return func(ctx context.Context, transaction *stroppy.DriverTransaction) (*stroppy.DriverTransactionResult, error) {
+		start := timestamppb.Now()
+
+		result, err := run(ctx, transaction)
+		if result == nil {
+			result = &stroppy.DriverTransactionResult{}
+		}
+
+		if result.GetStartTime() == nil {
+			result.StartTime = start
+		}
+
+		return r.Retry(ctx, run, transaction, result, err)
+	}
+}
+
+// Retry retries transaction with run after its first attempt finished with result and err.
+// Returned result is the one of the last attempt with retries added to Retries,
+// its start time is the start of the first attempt and id is kept.
+func (r *Retrier) Retry(
+	ctx context.Context,
+	run RunFunc,
+	transaction *stroppy.DriverTransaction,
+	result *stroppy.DriverTransactionResult,
+	err error,
+) (*stroppy.DriverTransactionResult, error) {
+	first := result
+
+	var retries uint32
+
+	for attempt := uint32(1); r.ShouldRetry(err, attempt); attempt++ {
+		if sleepCtx(ctx, r.Backoff(attempt)) != nil {
+			break
+		}
+
+		result, err = run(ctx, transaction)
+		retries++
+	}
+
+	if retries == 0 {
+		return result, err
+	}
+
+	if result == nil {
+		result = &stroppy.DriverTransactionResult{}
+	}
+
+	result.Id = first.GetId()
+	result.Retries += first.GetRetries() + retries
+
+	if first.GetStartTime() != nil {
+		result.StartTime = first.GetStartTime()
+	}
+
+	return result, err
+}
+
+func sleepCtx(ctx context.Context, delay time.Duration) error {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+type retryPlugin struct {
+	Plugin
+	retrier *Retrier
+}
+
+// WithRetryPolicy wraps plugin to retry failed transactions according to policy.
+// Batched and streamed transactions are retried one by one with RunTransaction.
+func WithRetryPolicy(plugin Plugin, policy *stroppy.RetryPolicy) Plugin { //nolint: ireturn // wrapper of any plugin
+	retrier := NewRetrier(policy)
+	if !retrier.Enabled() {
+		return plugin
+	}
+
+	return &retryPlugin{Plugin: plugin, retrier: retrier}
+}
+
+func (p *retryPlugin) GetCapabilities(ctx context.Context) (*stroppy.DriverCapabilities, error) {
+	return GetCapabilities(ctx, p.Plugin)
+}
+
+func (p *retryPlugin) RunTransaction(
+	ctx context.Context,
+	transaction *stroppy.DriverTransaction,
+) (*stroppy.DriverTransactionResult, error) {
+	return p.retrier.Wrap(p.Plugin.RunTransaction)(ctx, transaction)
+}
+
+// retryResult retries transaction of the failed result recorded by the wrapped plugin.
+func (p *retryPlugin) retryResult(
+	ctx context.Context,
+	transaction *stroppy.DriverTransaction,
+	result *stroppy.DriverTransactionResult,
+) *stroppy.DriverTransactionResult {
+	result, err := p.retrier.Retry(ctx, p.Plugin.RunTransaction, transaction, result, ResultError(result))
+	if result.GetEndTime() == nil {
+		result.EndTime = timestamppb.Now()
+	}
+
+	result.Error = ""
+	result.ErrorKind = stroppy.DriverErrorKind_DRIVER_ERROR_KIND_UNSPECIFIED
+	result.ErrorClass = stroppy.DriverErrorClass_DRIVER_ERROR_CLASS_UNSPECIFIED
+	SetResultError(result, err)
+
+	return result
+}
+
+func (p *retryPlugin) RunTransactions(
+	ctx context.Context,
+	transactions errchan.Chan[stroppy.DriverTransaction],
+) (errchan.Chan[stroppy.DriverTransactionResult], error) {
+	// Results come in order of transactions, so sent transactions are queued to pair them with results.
+	var (
+		mu      sync.Mutex
+		pending []*stroppy.DriverTransaction
+	)
+
+	forwarded := make(errchan.Chan[stroppy.DriverTransaction])
+
+	go func() {
+		defer errchan.Close[stroppy.DriverTransaction](forwarded)
+
+		for {
+			transaction, err := errchan.ReceiveCtx[stroppy.DriverTransaction](ctx, transactions)
+			if err == nil {
+				mu.Lock()
+				pending = append(pending, transaction)
+				mu.Unlock()
+			}
+
+			if errors.Is(err, errchan.ErrReceiveClosed) ||
+				errchan.SendCtx(ctx, forwarded, transaction, err) != nil || err != nil {
+				return
+			}
+		}
+	}()
+
+	inner, err := p.Plugin.RunTransactions(ctx, forwarded)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make(errchan.Chan[stroppy.DriverTransactionResult])
+
+	go func() {
+		defer errchan.Close[stroppy.DriverTransactionResult](results)
+
+		for {
+			result, err := errchan.ReceiveCtx[stroppy.DriverTransactionResult](ctx, inner)
+			if errors.Is(err, errchan.ErrReceiveClosed) {
+				return
+			}
+
+			if err == nil {
+				mu.Lock()
+
+				var transaction *stroppy.DriverTransaction
+				if len(pending) > 0 {
+					transaction, pending = pending[0], pending[1:]
+				}
+
+				mu.Unlock()
+
+				if transaction != nil {
+					result = p.retryResult(ctx, transaction, result)
+				}
+			}
+
+			if errchan.SendCtx(ctx, results, result, err) != nil || err != nil {
+				return
+			}
+		}
+	}()
+
+	return results, nil
+}
+
+func (p *retryPlugin) RunTransactionBatch(
+	ctx context.Context,
+	batch *stroppy.DriverTransactionList,
+) (*stroppy.DriverTransactionResultList, error) {
+	results, err := p.Plugin.RunTransactionBatch(ctx, batch)
+	if err != nil {
+		return nil, err
+	}
+
+	for idx, result := range results.GetResults() {
+		if idx < len(batch.GetTransactions()) {
+			results.Results[idx] = p.retryResult(ctx, batch.GetTransactions()[idx], result)
+		}
+	}
+
+	return results, nil
+}
@@ -0,0 +1,145 @@
+package driver
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	stroppy "github.com/stroppy-io/stroppy-core/pkg/proto"
+	"github.com/stroppy-io/stroppy-core/pkg/utils/errchan"
+)
+
+var errSerialization = errors.New("could not serialize access")
+
+// flakyPlugin fails every transaction failures times with retryable error,
+// transactions named "fatal" always fail with fatal error.
+type flakyPlugin struct {
+	TestPlugin
+	failures int
+
+	mu       sync.Mutex
+	attempts map[string]int
+}
+
+func (f *flakyPlugin) RunTransaction(
+	_ context.Context,
+	transaction *stroppy.DriverTransaction,
+) (*stroppy.DriverTransactionResult, error) {
+	name := transaction.GetQueries()[0].GetName()
+	if name == "fatal" {
+		return nil, Fatal(errBadConfig)
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.attempts[name]++
+	if f.attempts[name] <= f.failures {
+		return nil, NewTransactionError(stroppy.DriverErrorKind_DRIVER_ERROR_KIND_SERIALIZATION_FAILURE, errSerialization)
+	}
+
+	return &stroppy.DriverTransactionResult{}, nil
+}
+
+func (f *flakyPlugin) RunTransactions(
+	ctx context.Context,
+	transactions errchan.Chan[stroppy.DriverTransaction],
+) (errchan.Chan[stroppy.DriverTransactionResult], error) {
+	return RunTransactionsWith(ctx, f.RunTransaction, transactions), nil
+}
+
+func (f *flakyPlugin) RunTransactionBatch(
+	ctx context.Context,
+	batch *stroppy.DriverTransactionList,
+) (*stroppy.DriverTransactionResultList, error) {
+	return RunTransactionBatchWith(ctx, f.RunTransaction, batch)
+}
+
+func newFlakyPlugin(failures int) *flakyPlugin {
+	return &flakyPlugin{failures: failures, attempts: make(map[string]int)}
+}
+
+func testRetryPolicy(maxAttempts uint32) *stroppy.RetryPolicy {
+	return &stroppy.RetryPolicy{
+		MaxAttempts:    maxAttempts,
+		InitialBackoff: durationpb.New(time.Microsecond),
+	}
+}
+
+func TestRetrier_Backoff(t *testing.T) {
+	multiplier := 3.0
+	retrier := NewRetrier(&stroppy.RetryPolicy{
+		InitialBackoff:    durationpb.New(time.Millisecond),
+		MaxBackoff:        durationpb.New(5 * time.Millisecond),
+		BackoffMultiplier: &multiplier,
+	})
+
+	require.Equal(t, time.Millisecond, retrier.Backoff(1))
+	require.Equal(t, 3*time.Millisecond, retrier.Backoff(2))
+	require.Equal(t, 5*time.Millisecond, retrier.Backoff(3))
+	require.Equal(t, defaultInitialBackoff, NewRetrier(nil).Backoff(1))
+}
+
+func TestRetrier_ShouldRetry(t *testing.T) {
+	retryable := Retryable(errSerialization)
+	constraint := NewError(stroppy.DriverErrorClass_DRIVER_ERROR_CLASS_CONSTRAINT, errSerialization)
+
+	retrier := NewRetrier(testRetryPolicy(3))
+	require.True(t, retrier.ShouldRetry(retryable, 2))
+	require.False(t, retrier.ShouldRetry(retryable, 3))
+	require.False(t, retrier.ShouldRetry(constraint, 1))
+	require.False(t, retrier.ShouldRetry(nil, 1))
+
+	policy := testRetryPolicy(3)
+	policy.RetryClasses = []stroppy.DriverErrorClass{stroppy.DriverErrorClass_DRIVER_ERROR_CLASS_CONSTRAINT}
+	require.True(t, NewRetrier(policy).ShouldRetry(constraint, 1))
+	require.False(t, NewRetrier(policy).ShouldRetry(retryable, 1))
+}
+
+func TestWithRetryPolicy(t *testing.T) {
+	impl := newFlakyPlugin(2)
+	require.Same(t, Plugin(impl), WithRetryPolicy(impl, nil))
+
+	ctx := context.Background()
+	retrying := WithRetryPolicy(impl, testRetryPolicy(3))
+
+	result, err := retrying.RunTransaction(ctx, namedTransactions("a")[0])
+	require.NoError(t, err)
+	require.Equal(t, uint32(2), result.GetRetries())
+
+	result, err = retrying.RunTransaction(ctx, namedTransactions("fatal")[0])
+	require.ErrorIs(t, err, ErrFatal)
+	require.Zero(t, result.GetRetries())
+
+	result, err = WithRetryPolicy(newFlakyPlugin(2), testRetryPolicy(2)).RunTransaction(ctx, namedTransactions("a")[0])
+	require.ErrorIs(t, err, ErrRetryable)
+	require.Equal(t, uint32(1), result.GetRetries())
+}
+
+func TestWithRetryPolicy_Batch(t *testing.T) {
+	retrying := WithRetryPolicy(newFlakyPlugin(1), testRetryPolicy(2))
+	ctx := context.Background()
+
+	batch, err := retrying.RunTransactionBatch(ctx, &stroppy.DriverTransactionList{
+		Transactions: namedTransactions("a", "fatal", "b"),
+	})
+	require.NoError(t, err)
+	require.Len(t, batch.GetResults(), 3)
+	requireResults(t, batch.GetResults(), 1)
+	require.Equal(t, uint32(1), batch.GetResults()[0].GetRetries())
+	require.Equal(t, stroppy.DriverErrorClass_DRIVER_ERROR_CLASS_FATAL, batch.GetResults()[1].GetErrorClass())
+
+	stream, err := retrying.RunTransactions(ctx, sendAll(namedTransactions("c", "fatal", "d"), nil))
+	require.NoError(t, err)
+
+	results, err := errchan.Collect(stream)
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+	requireResults(t, results, 1)
+	require.Equal(t, uint32(1), results[2].GetRetries())
+}
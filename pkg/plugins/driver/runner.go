@@ -0,0 +1,127 @@
+package driver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	stroppy "github.com/stroppy-io/stroppy-core/pkg/proto"
+	"github.com/stroppy-io/stroppy-core/pkg/utils/errchan"
+)
+
+// RunFunc runs a single transaction, usually it is Plugin.RunTransaction.
+type RunFunc func(ctx context.Context, transaction *stroppy.DriverTransaction) (*stroppy.DriverTransactionResult, error)
+
+// RunTransactionTimed runs transaction with run and returns its result with id.
+// Start and end time are measured around run unless the driver has set them,
+// the returned error is recorded in the result with SetResultError.
+func RunTransactionTimed(
+	ctx context.Context,
+	id uint64,
+	run RunFunc,
+	transaction *stroppy.DriverTransaction,
+) *stroppy.DriverTransactionResult {
+	start := timestamppb.Now()
+
+	result, err := run(ctx, transaction)
+	if result == nil {
+		result = &stroppy.DriverTransactionResult{}
+	}
+
+	if result.GetEndTime() == nil {
+		result.EndTime = timestamppb.Now()
+	}
+
+	if result.GetStartTime() == nil {
+		result.StartTime = start
+	}
+
+	result.Id = id
+	SetResultError(result, err)
+
+	return result
+}
+
+// RunTransactionsWith runs transactions from the channel one by one with run and sends their results in order.
+// Failed transactions are reported in results and do not stop the stream, an error received
+// from transactions is forwarded to results and stops it.
+// Drivers without native pipelining may implement Plugin.RunTransactions with it.
+func RunTransactionsWith(
+	ctx context.Context,
+	run RunFunc,
+	transactions errchan.Chan[stroppy.DriverTransaction],
+) errchan.Chan[stroppy.DriverTransactionResult] {
+	results := make(errchan.Chan[stroppy.DriverTransactionResult])
+
+	go func() {
+		defer errchan.Close[stroppy.DriverTransactionResult](results)
+
+		for id := uint64(0); ; id++ {
+			transaction, err := errchan.ReceiveCtx[stroppy.DriverTransaction](ctx, transactions)
+			if errors.Is(err, errchan.ErrReceiveClosed) {
+				return
+			}
+
+			if err != nil {
+				_ = errchan.SendCtx[stroppy.DriverTransactionResult](ctx, results, nil, err)
+
+				return
+			}
+
+			result := RunTransactionTimed(ctx, id, run, transaction)
+			if errchan.SendCtx(ctx, results, result, nil) != nil {
+				return
+			}
+		}
+	}()
+
+	return results
+}
+
+// RunTransactionBatchWith runs all transactions of batch one by one with run.
+// Drivers without native batching may implement Plugin.RunTransactionBatch with it.
+func RunTransactionBatchWith(
+	ctx context.Context,
+	run RunFunc,
+	batch *stroppy.DriverTransactionList,
+) (*stroppy.DriverTransactionResultList, error) {
+	results := make([]*stroppy.DriverTransactionResult, 0, len(batch.GetTransactions()))
+
+	for id, transaction := range batch.GetTransactions() {
+		if err := ctx.Err(); err != nil {
+			return nil, fmt.Errorf("batch interrupted after %d transactions: %w", id, err)
+		}
+
+		results = append(results, RunTransactionTimed(ctx, uint64(id), run, transaction))
+	}
+
+	return &stroppy.DriverTransactionResultList{Results: results}, nil
+}
+
+// SequentialRunner implements Plugin.RunTransactions and Plugin.RunTransactionBatch
+// with RunTransactionsWith and RunTransactionBatchWith, drivers without native pipelining
+// and batching may embed it.
+type SequentialRunner struct {
+	run RunFunc
+}
+
+// NewSequentialRunner creates SequentialRunner running transactions with run, usually Plugin.RunTransaction.
+func NewSequentialRunner(run RunFunc) SequentialRunner {
+	return SequentialRunner{run: run}
+}
+
+func (r SequentialRunner) RunTransactions(
+	ctx context.Context,
+	transactions errchan.Chan[stroppy.DriverTransaction],
+) (errchan.Chan[stroppy.DriverTransactionResult], error) {
+	return RunTransactionsWith(ctx, r.run, transactions), nil
+}
+
+func (r SequentialRunner) RunTransactionBatch(
+	ctx context.Context,
+	batch *stroppy.DriverTransactionList,
+) (*stroppy.DriverTransactionResultList, error) {
+	return RunTransactionBatchWith(ctx, r.run, batch)
+}
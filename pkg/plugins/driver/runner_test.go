@@ -0,0 +1,147 @@
+package driver
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	stroppy "github.com/stroppy-io/stroppy-core/pkg/proto"
+	"github.com/stroppy-io/stroppy-core/pkg/utils/errchan"
+)
+
+var (
+	errFailedTransaction = errors.New("failed transaction")
+	errTestInput         = errors.New("input error")
+)
+
+// failNamed fails transactions which first query is named "fail".
+func failNamed(_ context.Context, transaction *stroppy.DriverTransaction) (*stroppy.DriverTransactionResult, error) {
+	if transaction.GetQueries()[0].GetName() == "fail" {
+		return nil, errFailedTransaction
+	}
+
+	return &stroppy.DriverTransactionResult{}, nil
+}
+
+func namedTransactions(names ...string) []*stroppy.DriverTransaction {
+	transactions := make([]*stroppy.DriverTransaction, 0, len(names))
+	for _, name := range names {
+		transactions = append(transactions, &stroppy.DriverTransaction{
+			Queries: []*stroppy.DriverQuery{{Name: name}},
+		})
+	}
+
+	return transactions
+}
+
+func sendAll(transactions []*stroppy.DriverTransaction, err error) errchan.Chan[stroppy.DriverTransaction] {
+	channel := make(errchan.Chan[stroppy.DriverTransaction])
+
+	go func() {
+		defer errchan.Close[stroppy.DriverTransaction](channel)
+
+		for _, transaction := range transactions {
+			errchan.Send(channel, transaction, nil)
+		}
+
+		if err != nil {
+			errchan.Send[stroppy.DriverTransaction](channel, nil, err)
+		}
+	}()
+
+	return channel
+}
+
+func requireResults(t *testing.T, results []*stroppy.DriverTransactionResult, failed ...uint64) {
+	t.Helper()
+
+	for idx, result := range results {
+		require.Equal(t, uint64(idx), result.GetId())
+		require.False(t, result.GetEndTime().AsTime().Before(result.GetStartTime().AsTime()))
+
+		if result.GetError() != "" {
+			require.Contains(t, failed, result.GetId())
+		} else {
+			require.NotContains(t, failed, result.GetId())
+		}
+	}
+}
+
+func TestRunTransactionsWith(t *testing.T) {
+	ctx := context.Background()
+
+	results, err := errchan.Collect(RunTransactionsWith(ctx, failNamed, sendAll(namedTransactions("a", "fail", "b"), nil)))
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+	requireResults(t, results, 1)
+
+	_, err = errchan.Collect(RunTransactionsWith(ctx, failNamed, sendAll(namedTransactions("a"), errTestInput)))
+	require.ErrorIs(t, err, errTestInput)
+}
+
+func TestRunTransactionBatchWith(t *testing.T) {
+	batch := &stroppy.DriverTransactionList{Transactions: namedTransactions("fail", "a")}
+
+	results, err := RunTransactionBatchWith(context.Background(), failNamed, batch)
+	require.NoError(t, err)
+	require.Len(t, results.GetResults(), 2)
+	requireResults(t, results.GetResults(), 0)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err = RunTransactionBatchWith(ctx, failNamed, batch)
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func TestSequentialRunner(t *testing.T) {
+	ctx := context.Background()
+	runner := NewSequentialRunner(failNamed)
+
+	stream, err := runner.RunTransactions(ctx, sendAll(namedTransactions("a", "fail"), nil))
+	require.NoError(t, err)
+
+	results, err := errchan.Collect(stream)
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	requireResults(t, results, 1)
+
+	batch, err := runner.RunTransactionBatch(ctx, &stroppy.DriverTransactionList{Transactions: namedTransactions("fail")})
+	require.NoError(t, err)
+	require.Len(t, batch.GetResults(), 1)
+	requireResults(t, batch.GetResults(), 0)
+}
+
+func TestClient_RunTransactions(t *testing.T) {
+	for _, version := range []int{pluginVersion, LatestPluginVersion} {
+		conn := dialVersionedTestServer(t, &TestPlugin{runTransactionErr: errFailedTransaction}, version)
+		driverClient := newDriverClient(stroppy.NewDriverPluginClient(conn))
+		driverClient.version = version
+
+		ctx := context.Background()
+
+		stream, err := driverClient.RunTransactions(ctx, sendAll(namedTransactions("a", "b", "c"), nil))
+		require.NoError(t, err)
+
+		results, err := errchan.Collect(stream)
+		require.NoError(t, err)
+		require.Len(t, results, 3)
+		requireResults(t, results, 0, 1, 2)
+		require.Contains(t, results[0].GetError(), errFailedTransaction.Error())
+
+		stream, err = driverClient.RunTransactions(ctx, sendAll(namedTransactions("a"), errTestInput))
+		require.NoError(t, err)
+
+		_, err = errchan.Collect(stream)
+		require.ErrorIs(t, err, errTestInput)
+
+		batch, err := driverClient.RunTransactionBatch(ctx, &stroppy.DriverTransactionList{
+			Transactions: namedTransactions("a", "b"),
+		})
+		require.NoError(t, err)
+		require.Len(t, batch.GetResults(), 2)
+		requireResults(t, batch.GetResults(), 0, 1)
+	}
+}
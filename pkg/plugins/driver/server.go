@@ -3,6 +3,7 @@ package driver
 import (
 	"context"
 	"errors"
+	"io"
 
 	"github.com/hashicorp/go-plugin"
 	"google.golang.org/grpc"
@@ -16,12 +17,15 @@ import (
 
 type server struct {
 	impl Plugin
+	// version is the protocol version negotiated with the host.
+	version int
 	*stroppy.UnimplementedDriverPluginServer
 }
 
-func newDriverServer(impl Plugin) *server {
+func newDriverServer(impl Plugin, version int) *server {
 	return &server{
 		impl:                            impl,
+		version:                         version,
 		UnimplementedDriverPluginServer: &stroppy.UnimplementedDriverPluginServer{},
 	}
 }
@@ -30,14 +34,16 @@ func (s server) Initialize(
 	ctx context.Context,
 	context *stroppy.StepContext,
 ) (*emptypb.Empty, error) {
-	return &emptypb.Empty{}, s.impl.Initialize(ctx, context)
+	return &emptypb.Empty{}, toStatusError(s.impl.Initialize(ctx, context))
 }
 
 func (s server) BuildTransactionsFromUnit(
 	ctx context.Context,
 	context *stroppy.UnitBuildContext,
 ) (*stroppy.DriverTransactionList, error) {
-	return s.impl.BuildTransactionsFromUnit(ctx, context)
+	transactions, err := s.impl.BuildTransactionsFromUnit(ctx, context)
+
+	return transactions, toStatusError(err)
 }
 
 func (s server) BuildTransactionsFromUnitStream(
@@ -46,7 +52,7 @@ func (s server) BuildTransactionsFromUnitStream(
 ) error {
 	innerStream, err := s.impl.BuildTransactionsFromUnitStream(stream.Context(), context)
 	if err != nil {
-		return err
+		return toStatusError(err)
 	}
 
 	for {
@@ -56,7 +62,7 @@ func (s server) BuildTransactionsFromUnitStream(
 				return nil
 			}
 
-			return err
+			return toStatusError(err)
 		}
 
 		err = stream.Send(data)
@@ -66,26 +72,92 @@ func (s server) BuildTransactionsFromUnitStream(
 	}
 }
 
+// RunTransaction returns transaction failures inside the result to keep its timings and error kind.
+// Hosts of older protocol versions decode the reply as Empty, they get failures as error status.
 func (s server) RunTransaction(
 	ctx context.Context,
 	transaction *stroppy.DriverTransaction,
-) (*emptypb.Empty, error) {
-	return &emptypb.Empty{}, s.impl.RunTransaction(ctx, transaction)
+) (*stroppy.DriverTransactionResult, error) {
+	if s.version < resultsPluginVersion {
+		result, err := s.impl.RunTransaction(ctx, transaction)
+
+		return result, toStatusError(err)
+	}
+
+	return RunTransactionTimed(ctx, 0, s.impl.RunTransaction, transaction), nil
+}
+
+func (s server) RunTransactions(
+	stream grpc.BidiStreamingServer[stroppy.DriverTransaction, stroppy.DriverTransactionResult],
+) error {
+	ctx := stream.Context()
+	transactions := make(errchan.Chan[stroppy.DriverTransaction])
+
+	go func() {
+		defer errchan.Close[stroppy.DriverTransaction](transactions)
+
+		for {
+			transaction, err := stream.Recv()
+			if errors.Is(err, io.EOF) {
+				return
+			}
+
+			if errchan.SendCtx(ctx, transactions, transaction, err) != nil || err != nil {
+				return
+			}
+		}
+	}()
+
+	results, err := s.impl.RunTransactions(ctx, transactions)
+	if err != nil {
+		return toStatusError(err)
+	}
+
+	for {
+		result, err := errchan.ReceiveCtx[stroppy.DriverTransactionResult](ctx, results)
+		if err != nil {
+			if errors.Is(err, errchan.ErrReceiveClosed) {
+				return nil
+			}
+
+			return toStatusError(err)
+		}
+
+		if err := stream.Send(result); err != nil {
+			return err
+		}
+	}
+}
+
+func (s server) RunTransactionBatch(
+	ctx context.Context,
+	batch *stroppy.DriverTransactionList,
+) (*stroppy.DriverTransactionResultList, error) {
+	results, err := s.impl.RunTransactionBatch(ctx, batch)
+
+	return results, toStatusError(err)
 }
 
 func (s server) Teardown(
 	ctx context.Context,
 	_ *emptypb.Empty,
 ) (*emptypb.Empty, error) {
-	return &emptypb.Empty{}, s.impl.Teardown(ctx)
+	return &emptypb.Empty{}, toStatusError(s.impl.Teardown(ctx))
+}
+
+func (s server) GetCapabilities(
+	ctx context.Context,
+	_ *emptypb.Empty,
+) (*stroppy.DriverCapabilities, error) {
+	capabilities, err := GetCapabilities(ctx, s.impl)
+
+	return capabilities, toStatusError(err)
 }
 
 func ServePlugin(impl Plugin) {
 	plugin.Serve(&plugin.ServeConfig{
-		HandshakeConfig: PluginHandshake,
-		Plugins: map[string]plugin.Plugin{
-			PluginName: NewSharedPlugin(impl),
-		},
+		HandshakeConfig:  PluginHandshake,
+		VersionedPlugins: VersionedPlugins(impl),
 		// A non-nil value here enables gRPC serving for this plugin...
 		GRPCServer: plugin.DefaultGRPCServer,
 		Logger:     common.NewLogger(logger.NewFromEnv()),
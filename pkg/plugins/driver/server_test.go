@@ -20,6 +20,7 @@ type TestPlugin struct {
 	buildTransactionsErr           error
 	buildTransactionsStreamErr     error
 	runTransactionErr              error
+	runTransactionResult           *stroppy.DriverTransactionResult
 	teardownErr                    error
 	buildTransactionsResult        *stroppy.DriverTransactionList
 	buildTransactionsStreamChannel errchan.Chan[stroppy.DriverTransaction]
@@ -49,8 +50,22 @@ func (t *TestPlugin) BuildTransactionsFromUnitStream(
 func (t *TestPlugin) RunTransaction(
 	_ context.Context,
 	_ *stroppy.DriverTransaction,
-) error {
-	return t.runTransactionErr
+) (*stroppy.DriverTransactionResult, error) {
+	return t.runTransactionResult, t.runTransactionErr
+}
+
+func (t *TestPlugin) RunTransactions(
+	ctx context.Context,
+	transactions errchan.Chan[stroppy.DriverTransaction],
+) (errchan.Chan[stroppy.DriverTransactionResult], error) {
+	return RunTransactionsWith(ctx, t.RunTransaction, transactions), nil
+}
+
+func (t *TestPlugin) RunTransactionBatch(
+	ctx context.Context,
+	batch *stroppy.DriverTransactionList,
+) (*stroppy.DriverTransactionResultList, error) {
+	return RunTransactionBatchWith(ctx, t.RunTransaction, batch)
 }
 
 func (t *TestPlugin) Teardown(_ context.Context) error {
@@ -59,7 +74,7 @@ func (t *TestPlugin) Teardown(_ context.Context) error {
 
 func TestNewDriverServer(t *testing.T) {
 	testPlugin := &TestPlugin{}
-	server := newDriverServer(testPlugin)
+	server := newDriverServer(testPlugin, LatestPluginVersion)
 
 	require.NotNil(t, server)
 	require.Equal(t, testPlugin, server.impl)
@@ -90,7 +105,7 @@ func TestServer_Initialize(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			server := newDriverServer(tt.plugin)
+			server := newDriverServer(tt.plugin, LatestPluginVersion)
 			ctx := context.Background()
 			stepContext := &stroppy.StepContext{}
 
@@ -163,7 +178,7 @@ func TestServer_BuildTransactionsFromUnit(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			server := newDriverServer(tt.plugin)
+			server := newDriverServer(tt.plugin, LatestPluginVersion)
 			ctx := context.Background()
 			buildContext := &stroppy.UnitBuildContext{}
 
@@ -204,7 +219,7 @@ func TestServer_RunTransaction(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			server := newDriverServer(tt.plugin)
+			server := newDriverServer(tt.plugin, LatestPluginVersion)
 			ctx := context.Background()
 			transaction := &stroppy.DriverTransaction{
 				Queries: []*stroppy.DriverQuery{
@@ -213,14 +228,15 @@ func TestServer_RunTransaction(t *testing.T) {
 			}
 
 			result, err := server.RunTransaction(ctx, transaction)
+			require.NoError(t, err)
+			require.NotNil(t, result)
+			require.NotNil(t, result.GetStartTime())
+			require.NotNil(t, result.GetEndTime())
 
 			if tt.wantErr {
-				require.Error(t, err)
-				require.NotNil(t, result)
+				require.Equal(t, "run error", result.GetError())
 			} else {
-				require.NoError(t, err)
-				require.NotNil(t, result)
-				require.IsType(t, &emptypb.Empty{}, result)
+				require.Empty(t, result.GetError())
 			}
 		})
 	}
@@ -250,7 +266,7 @@ func TestServer_Teardown(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			server := newDriverServer(tt.plugin)
+			server := newDriverServer(tt.plugin, LatestPluginVersion)
 			ctx := context.Background()
 
 			result, err := server.Teardown(ctx, &emptypb.Empty{})
@@ -273,7 +289,7 @@ func TestServePlugin(t *testing.T) {
 
 	server := grpc.NewServer()
 	testPlugin := &TestPlugin{}
-	stroppy.RegisterDriverPluginServer(server, newDriverServer(testPlugin))
+	stroppy.RegisterDriverPluginServer(server, newDriverServer(testPlugin, LatestPluginVersion))
 
 	go func() {
 		if err := server.Serve(listener); err != nil {
@@ -307,7 +323,7 @@ func TestServer_BuildTransactionsFromUnitStream(t *testing.T) {
 	testPlugin := &TestPlugin{
 		buildTransactionsStreamErr: errors.New("stream error"),
 	}
-	server := newDriverServer(testPlugin)
+	server := newDriverServer(testPlugin, LatestPluginVersion)
 	buildContext := &stroppy.UnitBuildContext{}
 
 	require.NotNil(t, server)
@@ -11,10 +11,21 @@ import (
 )
 
 const (
-	pluginVersion    = 1
-	magicCookieKey   = "stroppy_DRIVER_PLUGIN"
-	magicCookieValue = "stroppy_DRIVER_PLUGIN_HANDSHAKE"
-	PluginName       = "driver_grpc"
+	// pluginVersion is the base protocol version, hosts and plugins which
+	// do not negotiate VersionedPlugins speak it.
+	pluginVersion = 1
+	// capabilitiesPluginVersion adds GetCapabilities RPC.
+	capabilitiesPluginVersion = 2
+	// batchPluginVersion adds RunTransactions and RunTransactionBatch RPCs.
+	batchPluginVersion = 3
+	// resultsPluginVersion makes RunTransaction reply with DriverTransactionResult carrying
+	// transaction failures, older versions reply with Empty and report them as error status.
+	resultsPluginVersion = 4
+	// LatestPluginVersion is the newest protocol version supported by this package.
+	LatestPluginVersion = resultsPluginVersion
+	magicCookieKey      = "stroppy_DRIVER_PLUGIN"
+	magicCookieValue    = "stroppy_DRIVER_PLUGIN_HANDSHAKE"
+	PluginName          = "driver_grpc"
 )
 
 var PluginHandshake = plugin.HandshakeConfig{ //nolint: gochecknoglobals // allow in shared
@@ -24,6 +35,8 @@ var PluginHandshake = plugin.HandshakeConfig{ //nolint: gochecknoglobals // allo
 	MagicCookieValue: magicCookieValue,
 }
 
+// Plugin is implemented by drivers. Errors returned by its methods may be classified
+// with NewError to let the host decide whether to retry, see Error.
 type Plugin interface {
 	Initialize(ctx context.Context, runContext *stroppy.StepContext) error
 	BuildTransactionsFromUnit(
@@ -34,23 +47,62 @@ type Plugin interface {
 		ctx context.Context,
 		buildUnitContext *stroppy.UnitBuildContext,
 	) (errchan.Chan[stroppy.DriverTransaction], error)
-	RunTransaction(ctx context.Context, transaction *stroppy.DriverTransaction) error
+	// RunTransaction runs transaction and returns its result with query statistics.
+	// Transaction failures may be classified with NewTransactionError.
+	RunTransaction(
+		ctx context.Context,
+		transaction *stroppy.DriverTransaction,
+	) (*stroppy.DriverTransactionResult, error)
+	// RunTransactions runs transactions from the channel and sends their results in order,
+	// see RunTransactionsWith for a sequential implementation.
+	RunTransactions(
+		ctx context.Context,
+		transactions errchan.Chan[stroppy.DriverTransaction],
+	) (errchan.Chan[stroppy.DriverTransactionResult], error)
+	// RunTransactionBatch runs all transactions of batch and returns their results in order,
+	// see RunTransactionBatchWith for a sequential implementation.
+	RunTransactionBatch(
+		ctx context.Context,
+		batch *stroppy.DriverTransactionList,
+	) (*stroppy.DriverTransactionResultList, error)
 	Teardown(ctx context.Context) error
 }
 type SharedPlugin struct {
 	plugin.Plugin
-	Impl Plugin
+	Impl    Plugin
+	version int
 }
 
 func NewSharedPlugin(impl Plugin) *SharedPlugin {
-	return &SharedPlugin{Impl: impl}
+	return &SharedPlugin{Impl: impl, version: LatestPluginVersion}
+}
+
+// VersionedPlugins returns plugin sets of every supported protocol version.
+// go-plugin negotiates the highest version known to both host and plugin,
+// so a newer host can drive older plugins and vice versa.
+func VersionedPlugins(impl Plugin) map[int]plugin.PluginSet {
+	sets := make(map[int]plugin.PluginSet, LatestPluginVersion)
+
+	for version := pluginVersion; version <= LatestPluginVersion; version++ {
+		sets[version] = plugin.PluginSet{
+			PluginName: &SharedPlugin{Impl: impl, version: version},
+		}
+	}
+
+	return sets
 }
 
 func (s SharedPlugin) GRPCServer(
 	_ *plugin.GRPCBroker,
 	g *grpc.Server,
 ) error {
-	stroppy.RegisterDriverPluginServer(g, newDriverServer(s.Impl))
+	version := s.version
+	if version == 0 {
+		// Plugin sets without versions are negotiated with the handshake protocol version.
+		version = pluginVersion
+	}
+
+	stroppy.RegisterDriverPluginServer(g, newDriverServer(s.Impl, version))
 
 	return nil
 }
@@ -60,5 +112,10 @@ func (s SharedPlugin) GRPCClient(
 	_ *plugin.GRPCBroker,
 	conn *grpc.ClientConn,
 ) (interface{}, error) {
-	return newDriverClient(stroppy.NewDriverPluginClient(conn)), nil
+	driverClient := newDriverClient(stroppy.NewDriverPluginClient(conn))
+	if s.version != 0 {
+		driverClient.version = s.version
+	}
+
+	return driverClient, nil
 }
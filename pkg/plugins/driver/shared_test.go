@@ -71,7 +71,7 @@ func TestSharedPlugin_GRPCClient(t *testing.T) {
 
 	server := grpc.NewServer()
 	testPlugin := &TestPlugin{}
-	stroppy.RegisterDriverPluginServer(server, newDriverServer(testPlugin))
+	stroppy.RegisterDriverPluginServer(server, newDriverServer(testPlugin, LatestPluginVersion))
 
 	go func() {
 		if err := server.Serve(listener); err != nil {
@@ -126,7 +126,7 @@ func TestPluginInterface(t *testing.T) {
 	require.NoError(t, err)
 	require.Nil(t, channel)
 
-	err = testPlugin.RunTransaction(ctx, transaction)
+	_, err = testPlugin.RunTransaction(ctx, transaction)
 	require.NoError(t, err)
 
 	err = testPlugin.Teardown(ctx)
@@ -165,7 +165,7 @@ func TestPluginWithErrors(t *testing.T) {
 	require.Equal(t, "stream error", err.Error())
 	require.Nil(t, channel)
 
-	err = testPlugin.RunTransaction(ctx, transaction)
+	_, err = testPlugin.RunTransaction(ctx, transaction)
 	require.Error(t, err)
 	require.Equal(t, "run error", err.Error())
 
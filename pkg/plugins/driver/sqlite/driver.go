@@ -0,0 +1,302 @@
+// Package sqlite is the reference driver plugin backed by embedded SQLite.
+// It needs no database server and is used for offline end-to-end runs and as an example of a driver:
+// import it to run the driver in-process with DriverConfig.driver_name "sqlite"
+// or build cmd/stroppy-driver-sqlite to run it as a plugin binary.
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	_ "modernc.org/sqlite" // registers database/sql driver "sqlite"
+
+	"github.com/stroppy-io/stroppy-core/pkg/plugins/driver"
+	stroppy "github.com/stroppy-io/stroppy-core/pkg/proto"
+	"github.com/stroppy-io/stroppy-core/pkg/queries"
+)
+
+const (
+	// DriverName is the name of the driver in the driver registry and its capabilities.
+	DriverName = "sqlite"
+	// DefaultURL is the database used when DriverConfig.url is empty: a private in-memory database.
+	DefaultURL = ":memory:"
+)
+
+var ErrNotInitialized = errors.New("sqlite driver is not initialized")
+
+func init() { //nolint: gochecknoinits // registration of the linked-in driver
+	driver.Register(DriverName, func() driver.Plugin { return New() })
+}
+
+// Driver runs transactions on a single SQLite connection, so an in-memory database
+// lives until Teardown and transactions are executed one at a time.
+// Transactions are built with queries.TransactionBuilder and run one by one with driver.SequentialRunner.
+type Driver struct {
+	*queries.TransactionBuilder
+	driver.SequentialRunner
+
+	mu  sync.Mutex
+	url string
+	db  *sql.DB
+}
+
+func New() *Driver {
+	d := &Driver{TransactionBuilder: queries.NewTransactionBuilder(queries.PlaceholderQuestion)}
+	d.SequentialRunner = driver.NewSequentialRunner(d.RunTransaction)
+
+	return d
+}
+
+// Initialize opens the database of DriverConfig.url, it is reused by following steps with the same url.
+func (d *Driver) Initialize(ctx context.Context, runContext *stroppy.StepContext) error {
+	url := runContext.GetGlobalConfig().GetRun().GetDriver().GetUrl()
+	if url == "" {
+		url = DefaultURL
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.db != nil && d.url == url {
+		return nil
+	}
+
+	if err := d.closeLocked(); err != nil {
+		return err
+	}
+
+	db, err := sql.Open("sqlite", url)
+	if err != nil {
+		return fmt.Errorf("failed to open sqlite database '%s': %w", url, err)
+	}
+
+	// Every connection to ":memory:" is a separate database, and SQLite serializes writers anyway.
+	db.SetMaxOpenConns(1)
+	db.SetConnMaxIdleTime(0)
+
+	if err := db.PingContext(ctx); err != nil {
+		_ = db.Close()
+
+		return fmt.Errorf("failed to open sqlite database '%s': %w", url, err)
+	}
+
+	d.db, d.url = db, url
+
+	return nil
+}
+
+func (d *Driver) database() (*sql.DB, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.db == nil {
+		return nil, ErrNotInitialized
+	}
+
+	return d.db, nil
+}
+
+func (d *Driver) closeLocked() error {
+	if d.db == nil {
+		return nil
+	}
+
+	err := d.db.Close()
+	d.db, d.url = nil, ""
+
+	if err != nil {
+		return fmt.Errorf("failed to close sqlite database: %w", err)
+	}
+
+	return nil
+}
+
+func (d *Driver) Teardown(_ context.Context) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.closeLocked()
+}
+
+func (d *Driver) GetCapabilities(_ context.Context) (*stroppy.DriverCapabilities, error) {
+	return &stroppy.DriverCapabilities{
+		DriverName:      DriverName,
+		ProtocolVersion: driver.LatestPluginVersion,
+		UnitTypes:       d.UnitTypes(),
+		IsolationLevels: driver.AllIsolationLevels(),
+		StreamingBuild:  true,
+		BatchRun:        true,
+		ValueTypes: []stroppy.DriverCapabilities_ValueType{
+			stroppy.DriverCapabilities_VALUE_TYPE_NULL,
+			stroppy.DriverCapabilities_VALUE_TYPE_INT32,
+			stroppy.DriverCapabilities_VALUE_TYPE_UINT32,
+			stroppy.DriverCapabilities_VALUE_TYPE_INT64,
+			stroppy.DriverCapabilities_VALUE_TYPE_UINT64,
+			stroppy.DriverCapabilities_VALUE_TYPE_FLOAT,
+			stroppy.DriverCapabilities_VALUE_TYPE_DOUBLE,
+			stroppy.DriverCapabilities_VALUE_TYPE_STRING,
+			stroppy.DriverCapabilities_VALUE_TYPE_BOOL,
+			stroppy.DriverCapabilities_VALUE_TYPE_DECIMAL,
+			stroppy.DriverCapabilities_VALUE_TYPE_UUID,
+			stroppy.DriverCapabilities_VALUE_TYPE_DATETIME,
+		},
+		InsertMethods: []stroppy.InsertMethod{
+			stroppy.InsertMethod_INSERT_METHOD_PLAIN_QUERY,
+			stroppy.InsertMethod_INSERT_METHOD_BULK,
+		},
+	}, nil
+}
+
+// beginStatement maps isolation levels to SQLite transaction modes. SQLite transactions
+// are always serializable, repeatable read and serializable transactions take the write lock
+// at start so they fail fast with SQLITE_BUSY instead of on the first write.
+func beginStatement(level stroppy.TxIsolationLevel) string {
+	switch level {
+	case stroppy.TxIsolationLevel_TX_ISOLATION_LEVEL_REPEATABLE_READ,
+		stroppy.TxIsolationLevel_TX_ISOLATION_LEVEL_SERIALIZABLE:
+		return "BEGIN IMMEDIATE"
+	case stroppy.TxIsolationLevel_TX_ISOLATION_LEVEL_UNSPECIFIED,
+		stroppy.TxIsolationLevel_TX_ISOLATION_LEVEL_READ_UNCOMMITTED,
+		stroppy.TxIsolationLevel_TX_ISOLATION_LEVEL_READ_COMMITTED:
+		return "BEGIN DEFERRED"
+	default:
+		return "BEGIN DEFERRED"
+	}
+}
+
+// returnsRows reports whether the statement produces a result set which must be read.
+func returnsRows(request string) bool {
+	statement := strings.ToUpper(strings.TrimSpace(request))
+
+	for _, prefix := range []string{"SELECT", "WITH", "VALUES", "PRAGMA", "EXPLAIN"} {
+		if strings.HasPrefix(statement, prefix) {
+			return true
+		}
+	}
+
+	return strings.Contains(statement, " RETURNING ")
+}
+
+// RunTransaction runs queries of transaction or its bulk insert in a single SQLite transaction.
+func (d *Driver) RunTransaction(
+	ctx context.Context,
+	transaction *stroppy.DriverTransaction,
+) (*stroppy.DriverTransactionResult, error) {
+	db, err := d.database()
+	if err != nil {
+		return nil, err
+	}
+
+	queryList := transaction.GetQueries()
+	if transaction.GetBulkInsert() != nil {
+		queryList = queries.RenderInsertQueries(transaction.GetBulkInsert(), queries.PlaceholderQuestion)
+	}
+
+	result := &stroppy.DriverTransactionResult{
+		StartTime: timestamppb.Now(),
+		Queries:   make([]*stroppy.DriverQueryResult, 0, len(queryList)),
+	}
+
+	err = runQueries(ctx, db, transaction.GetIsolationLevel(), queryList, result)
+	result.EndTime = timestamppb.Now()
+
+	return result, err
+}
+
+func runQueries(
+	ctx context.Context,
+	db *sql.DB,
+	level stroppy.TxIsolationLevel,
+	queryList []*stroppy.DriverQuery,
+	result *stroppy.DriverTransactionResult,
+) error {
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to acquire sqlite connection: %w", err)
+	}
+	defer conn.Close()
+
+	if _, err := conn.ExecContext(ctx, beginStatement(level)); err != nil {
+		return classify(fmt.Errorf("failed to begin transaction: %w", err))
+	}
+
+	for _, query := range queryList {
+		queryResult, err := runQuery(ctx, conn, query)
+		if err != nil {
+			// Rollback must run even if ctx is done, otherwise the connection stays in the transaction.
+			_, _ = conn.ExecContext(context.WithoutCancel(ctx), "ROLLBACK")
+
+			return classify(fmt.Errorf("query '%s' failed: %w", query.GetName(), err))
+		}
+
+		result.Queries = append(result.Queries, queryResult)
+	}
+
+	if _, err := conn.ExecContext(ctx, "COMMIT"); err != nil {
+		_, _ = conn.ExecContext(context.WithoutCancel(ctx), "ROLLBACK")
+
+		return classify(fmt.Errorf("failed to commit transaction: %w", err))
+	}
+
+	return nil
+}
+
+func runQuery(ctx context.Context, conn *sql.Conn, query *stroppy.DriverQuery) (*stroppy.DriverQueryResult, error) {
+	args, err := paramArgs(query.GetParams())
+	if err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	queryResult := &stroppy.DriverQueryResult{Name: query.GetName()}
+
+	if returnsRows(query.GetRequest()) {
+		queryResult.RowsReturned, err = queryRows(ctx, conn, query.GetRequest(), args)
+	} else {
+		queryResult.RowsAffected, err = execRows(ctx, conn, query.GetRequest(), args)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	queryResult.Duration = durationpb.New(time.Since(start))
+
+	return queryResult, nil
+}
+
+func queryRows(ctx context.Context, conn *sql.Conn, request string, args []any) (uint64, error) {
+	rows, err := conn.QueryContext(ctx, request, args...)
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+
+	var count uint64
+	for rows.Next() {
+		count++
+	}
+
+	return count, rows.Err()
+}
+
+func execRows(ctx context.Context, conn *sql.Conn, request string, args []any) (uint64, error) {
+	res, err := conn.ExecContext(ctx, request, args...)
+	if err != nil {
+		return 0, err
+	}
+
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	return uint64(affected), nil //nolint: gosec // affected rows are not negative
+}
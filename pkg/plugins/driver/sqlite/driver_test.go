@@ -0,0 +1,168 @@
+package sqlite
+
+import (
+	"context"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/stroppy-io/stroppy-core/pkg/plugins/driver"
+	"github.com/stroppy-io/stroppy-core/pkg/plugins/driver/drivertest"
+	stroppy "github.com/stroppy-io/stroppy-core/pkg/proto"
+	"github.com/stroppy-io/stroppy-core/pkg/queries"
+)
+
+func TestConformance(t *testing.T) {
+	drivertest.Run(t, drivertest.Config{
+		New:         func() driver.Plugin { return New() },
+		Placeholder: queries.PlaceholderQuestion,
+	})
+}
+
+func TestConformance_Plugin(t *testing.T) {
+	if testing.Short() {
+		t.Skip("builds the plugin binary")
+	}
+
+	binary := filepath.Join(t.TempDir(), "stroppy-driver-sqlite")
+	build := exec.Command("go", "build", "-o", binary, "github.com/stroppy-io/stroppy-core/cmd/stroppy-driver-sqlite")
+	output, err := build.CombinedOutput()
+	require.NoError(t, err, string(output))
+
+	drivertest.Run(t, drivertest.Config{
+		RunConfig: &stroppy.RunConfig{Driver: &stroppy.DriverConfig{
+			DriverPluginPath: binary,
+			Url:              filepath.Join(t.TempDir(), "drivertest.db"),
+		}},
+		Placeholder:          queries.PlaceholderQuestion,
+		LargeTransactionSize: 100,
+	})
+}
+
+func int64Rule(minVal, maxVal int64) *stroppy.Generation_Rule {
+	return &stroppy.Generation_Rule{
+		Type: &stroppy.Generation_Rule_Int64Rules{
+			Int64Rules: &stroppy.Generation_Rules_Int64Rule{
+				Range: &stroppy.Generation_Range_Int64Range{Min: minVal, Max: maxVal},
+			},
+		},
+		Distribution: &stroppy.Generation_Distribution{Type: stroppy.Generation_Distribution_UNIFORM},
+	}
+}
+
+// TestRegisteredDriver runs a step of every unit type on the driver connected by its registered name.
+func TestRegisteredDriver(t *testing.T) {
+	plugin, err := driver.ConnectLocal(DriverName)
+	require.NoError(t, err)
+
+	units := []*stroppy.StepUnitDescriptor{
+		{Type: &stroppy.StepUnitDescriptor_CreateTable{CreateTable: &stroppy.TableDescriptor{
+			Name: "accounts",
+			Columns: []*stroppy.ColumnDescriptor{
+				{Name: "id", SqlType: "INTEGER"},
+				{Name: "balance", SqlType: "INTEGER"},
+			},
+		}}},
+		{Type: &stroppy.StepUnitDescriptor_Insert{Insert: &stroppy.InsertDescriptor{
+			Name:      "fill_accounts",
+			TableName: "accounts",
+			Count:     50,
+			BatchSize: 20,
+			Method:    stroppy.InsertMethod_INSERT_METHOD_BULK,
+			Columns: []*stroppy.InsertColumnDescriptor{
+				{Name: "id", GenerationRule: int64Rule(1, 1000)},
+				{Name: "balance", GenerationRule: int64Rule(0, 100)},
+			},
+		}}},
+		{Type: &stroppy.StepUnitDescriptor_Transaction{Transaction: &stroppy.TransactionDescriptor{
+			Name:           "transfer",
+			IsolationLevel: stroppy.TxIsolationLevel_TX_ISOLATION_LEVEL_SERIALIZABLE,
+			Count:          10,
+			Queries: []*stroppy.QueryDescriptor{
+				{Name: "debit", Sql: "UPDATE accounts SET balance = balance - 1 WHERE id > ${id}", Count: 1,
+					Params: []*stroppy.QueryParamDescriptor{{Name: "id", GenerationRule: int64Rule(1, 1000)}}},
+				{Name: "total", Sql: "SELECT sum(balance) FROM accounts", Count: 1},
+			},
+		}}},
+	}
+
+	ctx := context.Background()
+	step := &stroppy.StepContext{
+		Step:         &stroppy.StepDescriptor{Name: "load", Units: units},
+		GlobalConfig: &stroppy.Config{Run: &stroppy.RunConfig{Seed: 1}},
+	}
+	require.NoError(t, plugin.Initialize(ctx, step))
+
+	rowsAffected := make(map[string]uint64)
+
+	for _, unit := range units {
+		transactions, err := plugin.BuildTransactionsFromUnit(ctx, &stroppy.UnitBuildContext{Context: step, Unit: unit})
+		require.NoError(t, err)
+
+		results, err := plugin.RunTransactionBatch(ctx, transactions)
+		require.NoError(t, err)
+
+		for _, result := range results.GetResults() {
+			require.Empty(t, result.GetError())
+
+			for _, query := range result.GetQueries() {
+				rowsAffected[query.GetName()] += query.GetRowsAffected()
+			}
+		}
+	}
+
+	require.Equal(t, uint64(50), rowsAffected["fill_accounts"])
+	require.NoError(t, plugin.Teardown(ctx))
+}
+
+func TestDriver_CreateTableUnit(t *testing.T) {
+	unit := &stroppy.StepUnitDescriptor{Type: &stroppy.StepUnitDescriptor_CreateTable{CreateTable: &stroppy.TableDescriptor{
+		Name: "accounts",
+		Columns: []*stroppy.ColumnDescriptor{
+			{Name: "id", SqlType: "INTEGER", PrimaryKey: true},
+			{Name: "email", SqlType: "TEXT", Unique: true},
+			{Name: "balance", SqlType: "NUMERIC", Nullable: true, Constraint: "CHECK (balance >= 0)"},
+		},
+		TableIndexes: []*stroppy.IndexDescriptor{{Name: "accounts_balance", Columns: []string{"balance"}}},
+	}}}
+
+	transactions, err := New().BuildTransactionsFromUnit(context.Background(), &stroppy.UnitBuildContext{Unit: unit})
+	require.NoError(t, err)
+	require.Len(t, transactions.GetTransactions(), 1)
+
+	transaction := transactions.GetTransactions()[0]
+	require.Len(t, transaction.GetQueries(), 2)
+	require.Equal(t,
+		`CREATE TABLE IF NOT EXISTS "accounts" ("id" INTEGER NOT NULL, "email" TEXT NOT NULL UNIQUE, `+
+			`"balance" NUMERIC CHECK (balance >= 0), PRIMARY KEY ("id"))`,
+		transaction.GetQueries()[0].GetRequest())
+	require.Equal(t,
+		`CREATE INDEX IF NOT EXISTS "accounts_balance" ON "accounts" ("balance")`,
+		transaction.GetQueries()[1].GetRequest())
+}
+
+func TestParamArg(t *testing.T) {
+	tests := []struct {
+		name  string
+		value *stroppy.Value
+		want  any
+	}{
+		{"null", &stroppy.Value{Type: &stroppy.Value_Null{}}, nil},
+		{"uint32", &stroppy.Value{Type: &stroppy.Value_Uint32{Uint32: 7}}, int64(7)},
+		{"large uint64", &stroppy.Value{Type: &stroppy.Value_Uint64{Uint64: 1 << 63}}, "9223372036854775808"},
+		{"decimal", &stroppy.Value{Type: &stroppy.Value_Decimal{Decimal: &stroppy.Decimal{Value: "1.50"}}}, "1.50"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			arg, err := paramArg(tt.value)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, arg)
+		})
+	}
+
+	_, err := paramArg(&stroppy.Value{Type: &stroppy.Value_List_{}})
+	require.ErrorIs(t, err, ErrUnsupportedValue)
+}
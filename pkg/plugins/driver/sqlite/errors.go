@@ -0,0 +1,36 @@
+package sqlite
+
+import (
+	"errors"
+
+	"modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
+
+	"github.com/stroppy-io/stroppy-core/pkg/plugins/driver"
+	stroppy "github.com/stroppy-io/stroppy-core/pkg/proto"
+)
+
+// primaryCodeMask strips the extended part of SQLite result codes.
+const primaryCodeMask = 0xff
+
+// classify maps SQLite result codes of err to driver error kinds.
+// Lock conflicts are reported as serialization failures since SQLite transactions are serializable,
+// other SQL errors such as syntax errors or missing tables cannot succeed on retry and are fatal.
+// Context errors are returned as is, the driver package classifies deadlines as timeouts.
+func classify(err error) error {
+	var sqliteErr *sqlite.Error
+	if !errors.As(err, &sqliteErr) {
+		return err
+	}
+
+	switch sqliteErr.Code() & primaryCodeMask {
+	case sqlite3.SQLITE_BUSY, sqlite3.SQLITE_LOCKED:
+		return driver.NewTransactionError(stroppy.DriverErrorKind_DRIVER_ERROR_KIND_SERIALIZATION_FAILURE, err)
+	case sqlite3.SQLITE_CONSTRAINT:
+		return driver.NewTransactionError(stroppy.DriverErrorKind_DRIVER_ERROR_KIND_CONSTRAINT_VIOLATION, err)
+	case sqlite3.SQLITE_ERROR:
+		return driver.Fatal(err)
+	default:
+		return err
+	}
+}
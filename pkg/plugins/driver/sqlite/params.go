@@ -0,0 +1,66 @@
+package sqlite
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	stroppy "github.com/stroppy-io/stroppy-core/pkg/proto"
+)
+
+var ErrUnsupportedValue = errors.New("unsupported param value")
+
+// paramArg converts value to a database/sql argument of SQLite.
+// Unsigned integers above int64 range, decimals and uuids are bound as text,
+// datetimes as RFC 3339 text in UTC, so they compare and sort correctly.
+func paramArg(value *stroppy.Value) (any, error) {
+	switch typed := value.GetType().(type) {
+	case *stroppy.Value_Null:
+		return nil, nil
+	case *stroppy.Value_Int32:
+		return int64(typed.Int32), nil
+	case *stroppy.Value_Uint32:
+		return int64(typed.Uint32), nil
+	case *stroppy.Value_Int64:
+		return typed.Int64, nil
+	case *stroppy.Value_Uint64:
+		if typed.Uint64 > math.MaxInt64 {
+			return strconv.FormatUint(typed.Uint64, 10), nil
+		}
+
+		return int64(typed.Uint64), nil
+	case *stroppy.Value_Float:
+		return float64(typed.Float), nil
+	case *stroppy.Value_Double:
+		return typed.Double, nil
+	case *stroppy.Value_String_:
+		return typed.String_, nil
+	case *stroppy.Value_Bool:
+		return typed.Bool, nil
+	case *stroppy.Value_Decimal:
+		return typed.Decimal.GetValue(), nil
+	case *stroppy.Value_Uuid:
+		return typed.Uuid.GetValue(), nil
+	case *stroppy.Value_Datetime:
+		return typed.Datetime.GetValue().AsTime().UTC().Format(time.RFC3339Nano), nil
+	default:
+		return nil, fmt.Errorf("%w: %T", ErrUnsupportedValue, typed)
+	}
+}
+
+func paramArgs(params []*stroppy.Value) ([]any, error) {
+	args := make([]any, 0, len(params))
+
+	for idx, param := range params {
+		arg, err := paramArg(param)
+		if err != nil {
+			return nil, fmt.Errorf("param %d: %w", idx+1, err)
+		}
+
+		args = append(args, arg)
+	}
+
+	return args, nil
+}
@@ -0,0 +1,389 @@
+package driver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	stroppy "github.com/stroppy-io/stroppy-core/pkg/proto"
+	"github.com/stroppy-io/stroppy-core/pkg/utils/errchan"
+)
+
+var (
+	ErrPluginUnhealthy  = errors.New("driver plugin is unhealthy")
+	ErrRestartLimit     = errors.New("driver plugin restart limit reached")
+	ErrSupervisorClosed = errors.New("driver plugin is closed")
+)
+
+const (
+	// defaultRestartTimeout limits start and initialization of a restarted process.
+	defaultRestartTimeout = time.Minute
+	// exitWaitTimeout limits waiting for exit of a process after its connection is lost.
+	exitWaitTimeout  = 500 * time.Millisecond
+	exitPollInterval = 10 * time.Millisecond
+)
+
+type startFunc func() (process, error)
+
+// supervisedPlugin forwards calls to the driver plugin process, detects its exit
+// and optionally restarts it re-running Initialize with the last StepContext.
+// Errors of calls to the exited process are replaced with Error of kind connection lost
+// wrapping common.ExitError, it is classified as retryable if the plugin was restarted.
+type supervisedPlugin struct {
+	lg             *zap.Logger
+	start          startFunc
+	maxRestarts    uint32
+	restartTimeout time.Duration
+
+	// restartMu serializes restarts, it is not held by calls to the process.
+	restartMu   sync.Mutex
+	mu          sync.RWMutex
+	current     process
+	generation  uint64
+	restarts    uint32
+	stepContext *stroppy.StepContext
+	closed      bool
+
+	stop     chan struct{}
+	stopOnce sync.Once
+}
+
+// newSupervisedPlugin starts the process and checks its health each healthInterval if it is positive.
+func newSupervisedPlugin(
+	lg *zap.Logger,
+	start startFunc,
+	maxRestarts uint32,
+	healthInterval time.Duration,
+) (*supervisedPlugin, error) {
+	proc, err := start()
+	if err != nil {
+		return nil, err
+	}
+
+	supervisor := &supervisedPlugin{
+		lg:             lg,
+		start:          start,
+		maxRestarts:    maxRestarts,
+		restartTimeout: defaultRestartTimeout,
+		current:        proc,
+		stop:           make(chan struct{}),
+	}
+
+	if healthInterval > 0 {
+		go supervisor.watch(healthInterval)
+	}
+
+	return supervisor, nil
+}
+
+// Close stops health checks and kills the process.
+func (s *supervisedPlugin) Close() {
+	s.stopOnce.Do(func() {
+		close(s.stop)
+	})
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.closed = true
+	s.current.kill()
+}
+
+func (s *supervisedPlugin) acquire() (process, uint64) { //nolint: ireturn // internal
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.current, s.generation
+}
+
+// fail converts err of a call to proc of generation gen, see supervisedPlugin.
+func (s *supervisedPlugin) fail(ctx context.Context, proc process, gen uint64, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	crashErr := crashError(proc, err)
+	if crashErr == nil {
+		return err
+	}
+
+	class := stroppy.DriverErrorClass_DRIVER_ERROR_CLASS_FATAL
+
+	restartErr := s.restart(ctx, gen, crashErr)
+	if restartErr == nil {
+		class = stroppy.DriverErrorClass_DRIVER_ERROR_CLASS_RETRYABLE
+	} else {
+		s.lg.Error("driver plugin is not restarted", zap.Error(restartErr))
+	}
+
+	return &Error{Class: class, Kind: stroppy.DriverErrorKind_DRIVER_ERROR_KIND_CONNECTION_LOST, Err: crashErr}
+}
+
+// crashError returns the exit error of proc if err of a call to it was caused by a crash, nil otherwise.
+// The connection may be lost before go-plugin notices the exit, so after an Unavailable error
+// the exit is awaited shortly and the process is considered crashed if it does not answer a ping.
+func crashError(proc process, err error) error {
+	if exitErr := proc.exitError(); exitErr != nil {
+		return exitErr
+	}
+
+	if status.Code(err) != codes.Unavailable {
+		return nil
+	}
+
+	for deadline := time.Now().Add(exitWaitTimeout); time.Now().Before(deadline); {
+		time.Sleep(exitPollInterval)
+
+		if exitErr := proc.exitError(); exitErr != nil {
+			return exitErr
+		}
+	}
+
+	if pingErr := proc.ping(); pingErr != nil {
+		return fmt.Errorf("%w: %w", ErrPluginUnhealthy, pingErr)
+	}
+
+	return nil
+}
+
+// restart replaces the process of generation gen failed with cause.
+// It does nothing if the process has been already replaced. The new process is started
+// and initialized without blocking other calls and Close, it takes at most restartTimeout.
+func (s *supervisedPlugin) restart(ctx context.Context, gen uint64, cause error) error {
+	s.restartMu.Lock()
+	defer s.restartMu.Unlock()
+
+	s.mu.Lock()
+
+	switch {
+	case s.closed:
+		s.mu.Unlock()
+
+		return ErrSupervisorClosed
+	case gen != s.generation:
+		s.mu.Unlock()
+
+		return nil
+	case s.restarts >= s.maxRestarts:
+		s.mu.Unlock()
+
+		return fmt.Errorf("%w: %d restarts", ErrRestartLimit, s.restarts)
+	}
+
+	failed, stepContext := s.current, s.stepContext
+	s.restarts++
+	restarts := s.restarts
+	s.mu.Unlock()
+
+	failed.kill()
+
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), s.restartTimeout)
+	defer cancel()
+
+	proc, err := s.startProcess(ctx, stepContext)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		proc.kill()
+
+		return ErrSupervisorClosed
+	}
+
+	s.current = proc
+	s.generation++
+
+	s.lg.Warn("driver plugin restarted", zap.Uint32("restarts", restarts), zap.Error(cause))
+
+	return nil
+}
+
+// startProcess starts a new process and initializes it with stepContext if it is set.
+// The process is killed if it is not ready before ctx is done.
+func (s *supervisedPlugin) startProcess( //nolint: ireturn // internal
+	ctx context.Context,
+	stepContext *stroppy.StepContext,
+) (process, error) {
+	type started struct {
+		proc process
+		err  error
+	}
+
+	done := make(chan started, 1)
+
+	go func() {
+		proc, err := s.start()
+		done <- started{proc: proc, err: err}
+	}()
+
+	var result started
+
+	select {
+	case result = <-done:
+	case <-ctx.Done():
+		go func() {
+			if late := <-done; late.err == nil {
+				late.proc.kill()
+			}
+		}()
+
+		return nil, fmt.Errorf("failed to restart driver plugin: %w", ctx.Err())
+	}
+
+	if result.err != nil {
+		return nil, fmt.Errorf("failed to restart driver plugin: %w", result.err)
+	}
+
+	if stepContext == nil {
+		return result.proc, nil
+	}
+
+	if err := result.proc.plugin().Initialize(ctx, stepContext); err != nil {
+		result.proc.kill()
+
+		return nil, fmt.Errorf("failed to initialize restarted driver plugin: %w", err)
+	}
+
+	return result.proc, nil
+}
+
+// watch checks that the process is alive and pings it, unhealthy process is restarted.
+// Watching stops when the process can't be restarted anymore.
+func (s *supervisedPlugin) watch(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.stop:
+			return
+		case <-ticker.C:
+		}
+
+		proc, gen := s.acquire()
+
+		err := proc.exitError()
+		if err == nil {
+			pingErr := proc.ping()
+			if pingErr == nil {
+				continue
+			}
+
+			err = fmt.Errorf("%w: %w", ErrPluginUnhealthy, pingErr)
+		}
+
+		s.lg.Error("driver plugin health check failed", zap.Error(err))
+
+		restartErr := s.restart(context.Background(), gen, err)
+		if restartErr == nil {
+			continue
+		}
+
+		if errors.Is(restartErr, ErrRestartLimit) || errors.Is(restartErr, ErrSupervisorClosed) {
+			s.lg.Error("driver plugin health checks stopped", zap.Error(restartErr))
+
+			return
+		}
+
+		s.lg.Error("driver plugin is not restarted", zap.Error(restartErr))
+	}
+}
+
+func (s *supervisedPlugin) Initialize(ctx context.Context, runContext *stroppy.StepContext) error {
+	s.mu.Lock()
+	s.stepContext = runContext
+	s.mu.Unlock()
+
+	proc, gen := s.acquire()
+
+	return s.fail(ctx, proc, gen, proc.plugin().Initialize(ctx, runContext))
+}
+
+func (s *supervisedPlugin) BuildTransactionsFromUnit(
+	ctx context.Context,
+	buildUnitContext *stroppy.UnitBuildContext,
+) (*stroppy.DriverTransactionList, error) {
+	proc, gen := s.acquire()
+
+	transactions, err := proc.plugin().BuildTransactionsFromUnit(ctx, buildUnitContext)
+
+	return transactions, s.fail(ctx, proc, gen, err)
+}
+
+func (s *supervisedPlugin) BuildTransactionsFromUnitStream(
+	ctx context.Context,
+	buildUnitContext *stroppy.UnitBuildContext,
+) (errchan.Chan[stroppy.DriverTransaction], error) {
+	proc, gen := s.acquire()
+
+	transactions, err := proc.plugin().BuildTransactionsFromUnitStream(ctx, buildUnitContext)
+	if err != nil {
+		return nil, s.fail(ctx, proc, gen, err)
+	}
+
+	return forward(ctx, transactions, nil, func(err error) error {
+		return s.fail(ctx, proc, gen, err)
+	}), nil
+}
+
+func (s *supervisedPlugin) RunTransaction(
+	ctx context.Context,
+	transaction *stroppy.DriverTransaction,
+) (*stroppy.DriverTransactionResult, error) {
+	proc, gen := s.acquire()
+
+	result, err := proc.plugin().RunTransaction(ctx, transaction)
+
+	return result, s.fail(ctx, proc, gen, err)
+}
+
+func (s *supervisedPlugin) RunTransactions(
+	ctx context.Context,
+	transactions errchan.Chan[stroppy.DriverTransaction],
+) (errchan.Chan[stroppy.DriverTransactionResult], error) {
+	proc, gen := s.acquire()
+
+	results, err := proc.plugin().RunTransactions(ctx, transactions)
+	if err != nil {
+		return nil, s.fail(ctx, proc, gen, err)
+	}
+
+	return forward(ctx, results, nil, func(err error) error {
+		return s.fail(ctx, proc, gen, err)
+	}), nil
+}
+
+func (s *supervisedPlugin) RunTransactionBatch(
+	ctx context.Context,
+	batch *stroppy.DriverTransactionList,
+) (*stroppy.DriverTransactionResultList, error) {
+	proc, gen := s.acquire()
+
+	results, err := proc.plugin().RunTransactionBatch(ctx, batch)
+
+	return results, s.fail(ctx, proc, gen, err)
+}
+
+func (s *supervisedPlugin) Teardown(ctx context.Context) error {
+	proc, gen := s.acquire()
+
+	return s.fail(ctx, proc, gen, proc.plugin().Teardown(ctx))
+}
+
+func (s *supervisedPlugin) GetCapabilities(ctx context.Context) (*stroppy.DriverCapabilities, error) {
+	proc, gen := s.acquire()
+
+	capabilities, err := GetCapabilities(ctx, proc.plugin())
+
+	return capabilities, s.fail(ctx, proc, gen, err)
+}
@@ -0,0 +1,276 @@
+package driver
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/stroppy-io/stroppy-core/pkg/plugins/common"
+	stroppy "github.com/stroppy-io/stroppy-core/pkg/proto"
+)
+
+var errUnavailable = errors.New("connection is unavailable")
+
+type initializePlugin struct {
+	TestPlugin
+	initialized atomic.Pointer[stroppy.StepContext]
+}
+
+func (i *initializePlugin) Initialize(_ context.Context, runContext *stroppy.StepContext) error {
+	i.initialized.Store(runContext)
+
+	return nil
+}
+
+type fakeProcess struct {
+	impl *initializePlugin
+
+	mu      sync.Mutex
+	exited  bool
+	killed  bool
+	pingErr error
+}
+
+func (f *fakeProcess) plugin() Plugin { //nolint: ireturn // test
+	return f.impl
+}
+
+func (f *fakeProcess) exitError() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if !f.exited {
+		return nil
+	}
+
+	return common.NewExitError(nil, []string{"SIGSEGV: segmentation violation"})
+}
+
+func (f *fakeProcess) ping() error {
+	f.mu.Lock()
+	pingErr := f.pingErr
+	f.mu.Unlock()
+
+	if pingErr != nil {
+		return pingErr
+	}
+
+	return f.exitError()
+}
+
+func (f *fakeProcess) kill() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.killed = true
+}
+
+func (f *fakeProcess) crash() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.exited = true
+	f.impl.teardownErr = errUnavailable
+}
+
+func (f *fakeProcess) isKilled() bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.killed
+}
+
+// loseConnection fails calls with Unavailable status, the exit is noticed after exitDelay if it is positive.
+func (f *fakeProcess) loseConnection(exitDelay time.Duration) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.impl.teardownErr = status.Error(codes.Unavailable, errUnavailable.Error())
+	f.pingErr = errUnavailable
+
+	if exitDelay > 0 {
+		time.AfterFunc(exitDelay, func() {
+			f.mu.Lock()
+			defer f.mu.Unlock()
+
+			f.exited = true
+		})
+	}
+}
+
+type fakeStarter struct {
+	mu        sync.Mutex
+	processes []*fakeProcess
+	// block holds starts after the first one until it is closed if it is set.
+	block chan struct{}
+}
+
+func (f *fakeStarter) start() (process, error) { //nolint: ireturn // test
+	f.mu.Lock()
+	block := f.block
+	started := len(f.processes) > 0
+	f.mu.Unlock()
+
+	if block != nil && started {
+		<-block
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	proc := &fakeProcess{impl: &initializePlugin{}}
+	f.processes = append(f.processes, proc)
+
+	return proc, nil
+}
+
+func (f *fakeStarter) last() *fakeProcess {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.processes[len(f.processes)-1]
+}
+
+func (f *fakeStarter) count() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return len(f.processes)
+}
+
+func TestSupervisedPlugin_Restart(t *testing.T) {
+	starter := &fakeStarter{}
+	supervisor, err := newSupervisedPlugin(zap.NewNop(), starter.start, 1, 0)
+	require.NoError(t, err)
+
+	defer supervisor.Close()
+
+	ctx := context.Background()
+	stepContext := &stroppy.StepContext{}
+
+	require.NoError(t, supervisor.Initialize(ctx, stepContext))
+
+	crashed := starter.last()
+	crashed.crash()
+
+	err = supervisor.Teardown(ctx)
+	require.ErrorIs(t, err, common.ErrPluginExited)
+	require.ErrorIs(t, err, ErrRetryable)
+	require.Equal(t, stroppy.DriverErrorKind_DRIVER_ERROR_KIND_CONNECTION_LOST, ErrorKindOf(err))
+	require.Contains(t, err.Error(), "segmentation violation")
+	require.True(t, crashed.isKilled())
+	require.Same(t, stepContext, starter.last().impl.initialized.Load())
+
+	starter.last().crash()
+
+	err = supervisor.Teardown(ctx)
+	require.ErrorIs(t, err, ErrFatal)
+	require.Equal(t, 2, starter.count())
+}
+
+func TestSupervisedPlugin_HealthCheck(t *testing.T) {
+	starter := &fakeStarter{}
+	supervisor, err := newSupervisedPlugin(zap.NewNop(), starter.start, 1, time.Millisecond)
+	require.NoError(t, err)
+
+	starter.last().crash()
+
+	require.Eventually(t, func() bool {
+		proc, _ := supervisor.acquire()
+
+		return starter.count() == 2 && proc == starter.last()
+	}, time.Second, time.Millisecond)
+
+	supervisor.Close()
+	require.True(t, starter.last().isKilled())
+	require.NoError(t, supervisor.Teardown(context.Background()))
+}
+
+func TestSupervisedPlugin_LostConnection(t *testing.T) {
+	tests := []struct {
+		name      string
+		exitDelay time.Duration
+		wantErr   error
+	}{
+		{"exit noticed late", 50 * time.Millisecond, common.ErrPluginExited},
+		{"ping failed", 0, ErrPluginUnhealthy},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			starter := &fakeStarter{}
+			supervisor, err := newSupervisedPlugin(zap.NewNop(), starter.start, 1, 0)
+			require.NoError(t, err)
+
+			defer supervisor.Close()
+
+			starter.last().loseConnection(tt.exitDelay)
+
+			err = supervisor.Teardown(context.Background())
+			require.ErrorIs(t, err, tt.wantErr)
+			require.ErrorIs(t, err, ErrRetryable)
+			require.Equal(t, 2, starter.count())
+		})
+	}
+}
+
+func TestSupervisedPlugin_HangingRestart(t *testing.T) {
+	starter := &fakeStarter{block: make(chan struct{})}
+	defer close(starter.block)
+
+	supervisor, err := newSupervisedPlugin(zap.NewNop(), starter.start, 1, 0)
+	require.NoError(t, err)
+
+	supervisor.restartTimeout = 50 * time.Millisecond
+
+	starter.last().crash()
+
+	teardownErr := make(chan error, 1)
+
+	go func() {
+		teardownErr <- supervisor.Teardown(context.Background())
+	}()
+
+	require.Eventually(t, func() bool {
+		return starter.last().isKilled()
+	}, time.Second, time.Millisecond)
+
+	// Calls and Close are not blocked by the hanging restart.
+	proc, _ := supervisor.acquire()
+	require.Same(t, starter.last(), proc)
+	supervisor.Close()
+
+	err = <-teardownErr
+	require.ErrorIs(t, err, ErrFatal)
+	require.ErrorIs(t, err, common.ErrPluginExited)
+}
+
+func TestSupervisedPlugin_HealthCheckStops(t *testing.T) {
+	core, logs := observer.New(zapcore.ErrorLevel)
+	starter := &fakeStarter{}
+
+	supervisor, err := newSupervisedPlugin(zap.New(core), starter.start, 0, time.Millisecond)
+	require.NoError(t, err)
+
+	defer supervisor.Close()
+
+	starter.last().loseConnection(0)
+
+	require.Eventually(t, func() bool {
+		return logs.FilterMessage("driver plugin health checks stopped").Len() == 1
+	}, time.Second, time.Millisecond)
+
+	time.Sleep(20 * time.Millisecond)
+	require.Equal(t, 2, logs.Len())
+	require.Equal(t, 1, starter.count())
+}
@@ -0,0 +1,82 @@
+// Package txlog captures workloads as transaction logs and replays them through any driver,
+// so databases or their versions may be compared with byte-identical input.
+//
+// A log is a stream of length-delimited TransactionLogEntry protobuf messages,
+// each entry holds a transaction and the time it was sent to the driver.
+package txlog
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protodelim"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	stroppy "github.com/stroppy-io/stroppy-core/pkg/proto"
+)
+
+// Writer appends entries to a transaction log, it is safe for concurrent use.
+// Entries are buffered until Flush.
+type Writer struct {
+	mu     sync.Mutex
+	writer *bufio.Writer
+}
+
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{writer: bufio.NewWriter(w)}
+}
+
+// Write appends transaction sent at the given time.
+func (w *Writer) Write(sent time.Time, transaction *stroppy.DriverTransaction) error {
+	entry := &stroppy.TransactionLogEntry{Time: timestamppb.New(sent), Transaction: transaction}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if _, err := protodelim.MarshalTo(w.writer, entry); err != nil {
+		return fmt.Errorf("failed to write transaction log entry: %w", err)
+	}
+
+	return nil
+}
+
+// Flush writes buffered entries to the underlying writer.
+func (w *Writer) Flush() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if err := w.writer.Flush(); err != nil {
+		return fmt.Errorf("failed to flush transaction log: %w", err)
+	}
+
+	return nil
+}
+
+// Reader reads entries of a transaction log one by one.
+type Reader struct {
+	reader *bufio.Reader
+}
+
+func NewReader(r io.Reader) *Reader {
+	return &Reader{reader: bufio.NewReader(r)}
+}
+
+// Next returns the next entry of the log, io.EOF at its end.
+// Entries are not limited in size, since transactions run in-process may exceed gRPC limits.
+func (r *Reader) Next() (*stroppy.TransactionLogEntry, error) {
+	entry := &stroppy.TransactionLogEntry{}
+
+	err := protodelim.UnmarshalOptions{MaxSize: -1}.UnmarshalFrom(r.reader, entry)
+	if err == io.EOF { //nolint: errorlint // protodelim returns bare io.EOF at the end of stream
+		return nil, io.EOF
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to read transaction log entry: %w", err)
+	}
+
+	return entry, nil
+}
@@ -0,0 +1,89 @@
+package txlog
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/stroppy-io/stroppy-core/pkg/plugins/driver"
+	stroppy "github.com/stroppy-io/stroppy-core/pkg/proto"
+	"github.com/stroppy-io/stroppy-core/pkg/utils/errchan"
+)
+
+type recordingPlugin struct {
+	driver.Plugin
+	log *Writer
+}
+
+// Record wraps plugin to write every transaction sent to it to log before it is run.
+// A transaction which cannot be logged fails without running, Teardown flushes the log.
+func Record(plugin driver.Plugin, log *Writer) driver.Plugin { //nolint: ireturn // wrapper of any plugin
+	return &recordingPlugin{Plugin: plugin, log: log}
+}
+
+func (p *recordingPlugin) GetCapabilities(ctx context.Context) (*stroppy.DriverCapabilities, error) {
+	return driver.GetCapabilities(ctx, p.Plugin)
+}
+
+func (p *recordingPlugin) RunTransaction(
+	ctx context.Context,
+	transaction *stroppy.DriverTransaction,
+) (*stroppy.DriverTransactionResult, error) {
+	if err := p.log.Write(time.Now(), transaction); err != nil {
+		return nil, err
+	}
+
+	return p.Plugin.RunTransaction(ctx, transaction)
+}
+
+func (p *recordingPlugin) RunTransactions(
+	ctx context.Context,
+	transactions errchan.Chan[stroppy.DriverTransaction],
+) (errchan.Chan[stroppy.DriverTransactionResult], error) {
+	logged := make(errchan.Chan[stroppy.DriverTransaction])
+
+	results, err := p.Plugin.RunTransactions(ctx, logged)
+	if err != nil {
+		return nil, err
+	}
+
+	go func() {
+		defer errchan.Close[stroppy.DriverTransaction](logged)
+
+		for {
+			transaction, err := errchan.ReceiveCtx[stroppy.DriverTransaction](ctx, transactions)
+			if errors.Is(err, errchan.ErrReceiveClosed) {
+				return
+			}
+
+			if err == nil {
+				err = p.log.Write(time.Now(), transaction)
+			}
+
+			if errchan.SendCtx(ctx, logged, transaction, err) != nil || err != nil {
+				return
+			}
+		}
+	}()
+
+	return results, nil
+}
+
+func (p *recordingPlugin) RunTransactionBatch(
+	ctx context.Context,
+	batch *stroppy.DriverTransactionList,
+) (*stroppy.DriverTransactionResultList, error) {
+	sent := time.Now()
+
+	for _, transaction := range batch.GetTransactions() {
+		if err := p.log.Write(sent, transaction); err != nil {
+			return nil, err
+		}
+	}
+
+	return p.Plugin.RunTransactionBatch(ctx, batch)
+}
+
+func (p *recordingPlugin) Teardown(ctx context.Context) error {
+	return errors.Join(p.Plugin.Teardown(ctx), p.log.Flush())
+}
@@ -0,0 +1,188 @@
+package txlog
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/stroppy-io/stroppy-core/pkg/plugins/driver"
+	stroppy "github.com/stroppy-io/stroppy-core/pkg/proto"
+	"github.com/stroppy-io/stroppy-core/pkg/utils/errchan"
+)
+
+// MaxConcurrentTransactions limits transactions run at once by OriginalTiming replay.
+const MaxConcurrentTransactions = 64
+
+// Timing selects how Replay paces transactions of the log.
+type Timing int
+
+const (
+	// AsFastAsPossible sends transactions one by one with RunTransactions, every transaction is sent
+	// as soon as the driver accepts it.
+	AsFastAsPossible Timing = iota
+	// OriginalTiming starts every transaction with RunTransaction at its recorded offset from the first one,
+	// so transactions of concurrent workers overlap as they did when they were recorded.
+	// A transaction is started late only if MaxConcurrentTransactions are still running.
+	OriginalTiming
+)
+
+// Replay sends transactions of log to plugin, paced by timing, and returns their results in log order
+// with ids of their positions in the log. A broken log stops the stream with an error, the end of the log closes it.
+func Replay(
+	ctx context.Context,
+	plugin driver.Plugin,
+	log *Reader,
+	timing Timing,
+) (errchan.Chan[stroppy.DriverTransactionResult], error) {
+	if timing == OriginalTiming {
+		return replayConcurrently(ctx, plugin, log), nil
+	}
+
+	transactions := make(errchan.Chan[stroppy.DriverTransaction])
+
+	results, err := plugin.RunTransactions(ctx, transactions)
+	if err != nil {
+		return nil, err
+	}
+
+	go func() {
+		defer errchan.Close[stroppy.DriverTransaction](transactions)
+
+		for {
+			entry, err := log.Next()
+			if errors.Is(err, io.EOF) {
+				return
+			}
+
+			if err != nil {
+				_ = errchan.SendCtx[stroppy.DriverTransaction](ctx, transactions, nil, err)
+
+				return
+			}
+
+			if errchan.SendCtx(ctx, transactions, entry.GetTransaction(), nil) != nil {
+				return
+			}
+		}
+	}()
+
+	return results, nil
+}
+
+// replayed is the outcome of a transaction of the log, or the error of reading the log.
+type replayed struct {
+	result *stroppy.DriverTransactionResult
+	err    error
+}
+
+// replayConcurrently starts transactions at their recorded offsets, at most MaxConcurrentTransactions at once.
+// Pending outcomes are queued in log order, so results are sent in log order as they complete.
+func replayConcurrently(
+	ctx context.Context,
+	plugin driver.Plugin,
+	log *Reader,
+) errchan.Chan[stroppy.DriverTransactionResult] {
+	results := make(errchan.Chan[stroppy.DriverTransactionResult])
+	pending := make(chan chan replayed, MaxConcurrentTransactions)
+
+	go dispatch(ctx, plugin, log, pending)
+
+	go func() {
+		defer errchan.Close[stroppy.DriverTransactionResult](results)
+
+		for outcome := range pending {
+			var next replayed
+
+			select {
+			case <-ctx.Done():
+				return
+			case next = <-outcome:
+			}
+
+			if errchan.SendCtx(ctx, results, next.result, next.err) != nil || next.err != nil {
+				return
+			}
+		}
+	}()
+
+	return results
+}
+
+func dispatch(ctx context.Context, plugin driver.Plugin, log *Reader, pending chan<- chan replayed) {
+	defer close(pending)
+
+	var first time.Time
+
+	slots := make(chan struct{}, MaxConcurrentTransactions)
+	start := time.Now()
+
+	for id := uint64(0); ; id++ {
+		entry, err := log.Next()
+		if errors.Is(err, io.EOF) {
+			return
+		}
+
+		outcome := make(chan replayed, 1)
+
+		if err != nil {
+			outcome <- replayed{err: err}
+			enqueue(ctx, pending, outcome)
+
+			return
+		}
+
+		sent := entry.GetTime().AsTime()
+		if first.IsZero() {
+			first = sent
+		}
+
+		if waitUntil(ctx, start.Add(sent.Sub(first))) != nil {
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case slots <- struct{}{}:
+		}
+
+		if !enqueue(ctx, pending, outcome) {
+			return
+		}
+
+		go func() {
+			defer func() { <-slots }()
+
+			result := driver.RunTransactionTimed(ctx, id, plugin.RunTransaction, entry.GetTransaction())
+			outcome <- replayed{result: result}
+		}()
+	}
+}
+
+// enqueue queues outcome unless ctx is done, as results are not received after it.
+func enqueue(ctx context.Context, pending chan<- chan replayed, outcome chan replayed) bool {
+	select {
+	case <-ctx.Done():
+		return false
+	case pending <- outcome:
+		return true
+	}
+}
+
+func waitUntil(ctx context.Context, deadline time.Time) error {
+	delay := time.Until(deadline)
+	if delay <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
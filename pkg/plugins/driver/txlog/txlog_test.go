@@ -0,0 +1,173 @@
+package txlog
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/stroppy-io/stroppy-core/pkg/plugins/driver"
+	"github.com/stroppy-io/stroppy-core/pkg/plugins/driver/mock"
+	stroppy "github.com/stroppy-io/stroppy-core/pkg/proto"
+	"github.com/stroppy-io/stroppy-core/pkg/queries"
+	"github.com/stroppy-io/stroppy-core/pkg/utils/errchan"
+)
+
+func transaction(name string, id int64) *stroppy.DriverTransaction {
+	return &stroppy.DriverTransaction{
+		IsolationLevel: stroppy.TxIsolationLevel_TX_ISOLATION_LEVEL_SERIALIZABLE,
+		Queries: []*stroppy.DriverQuery{{
+			Name:    name,
+			Request: "UPDATE t SET v = v + 1 WHERE id = $1",
+			Params:  []*stroppy.Value{{Type: &stroppy.Value_Int64{Int64: id}}},
+		}},
+	}
+}
+
+func sendAll(transactions ...*stroppy.DriverTransaction) errchan.Chan[stroppy.DriverTransaction] {
+	channel := make(errchan.Chan[stroppy.DriverTransaction], len(transactions))
+	for _, transaction := range transactions {
+		errchan.Send(channel, transaction, nil)
+	}
+
+	errchan.Close[stroppy.DriverTransaction](channel)
+
+	return channel
+}
+
+func TestRecordAndReplay(t *testing.T) {
+	ctx := context.Background()
+
+	var log bytes.Buffer
+
+	recorded := mock.New(queries.PlaceholderDollar)
+	plugin := Record(driver.NewLocal(recorded), NewWriter(&log))
+
+	_, err := plugin.RunTransaction(ctx, transaction("single", 1))
+	require.NoError(t, err)
+
+	results, err := plugin.RunTransactions(ctx, sendAll(transaction("stream", 2), transaction("stream", 3)))
+	require.NoError(t, err)
+
+	_, err = errchan.Collect(results)
+	require.NoError(t, err)
+
+	_, err = plugin.RunTransactionBatch(ctx, &stroppy.DriverTransactionList{
+		Transactions: []*stroppy.DriverTransaction{transaction("batch", 4)},
+	})
+	require.NoError(t, err)
+	require.NoError(t, plugin.Teardown(ctx))
+
+	replayed := mock.New(queries.PlaceholderDollar)
+
+	results, err = Replay(ctx, driver.NewLocal(replayed), NewReader(&log), AsFastAsPossible)
+	require.NoError(t, err)
+
+	replayResults, err := errchan.Collect(results)
+	require.NoError(t, err)
+	require.Len(t, replayResults, 4)
+
+	require.Len(t, replayed.Transactions(), len(recorded.Transactions()))
+
+	for idx, transaction := range recorded.Transactions() {
+		require.True(t, proto.Equal(transaction, replayed.Transactions()[idx]), "transaction %d", idx)
+	}
+}
+
+func TestReplay_OriginalTiming(t *testing.T) {
+	var log bytes.Buffer
+
+	writer := NewWriter(&log)
+	sent := time.Now()
+
+	for idx, offset := range []time.Duration{0, 30 * time.Millisecond, 60 * time.Millisecond} {
+		require.NoError(t, writer.Write(sent.Add(offset), transaction("paced", int64(idx))))
+	}
+
+	require.NoError(t, writer.Flush())
+
+	start := time.Now()
+
+	results, err := Replay(context.Background(), driver.NewLocal(mock.New(queries.PlaceholderDollar)),
+		NewReader(bytes.NewReader(log.Bytes())), OriginalTiming)
+	require.NoError(t, err)
+
+	replayResults, err := errchan.Collect(results)
+	require.NoError(t, err)
+	require.Len(t, replayResults, 3)
+	require.GreaterOrEqual(t, time.Since(start), 60*time.Millisecond)
+}
+
+func TestReplay_OriginalTimingConcurrent(t *testing.T) {
+	var log bytes.Buffer
+
+	writer := NewWriter(&log)
+	sent := time.Now()
+
+	for idx := range 5 {
+		require.NoError(t, writer.Write(sent.Add(time.Duration(idx)*10*time.Millisecond), transaction("overlap", int64(idx))))
+	}
+
+	require.NoError(t, writer.Flush())
+
+	// Transactions take longer than gaps between them, as if they were recorded from concurrent workers.
+	latency := durationpb.New(100 * time.Millisecond)
+	slow := mock.New(queries.PlaceholderDollar)
+	require.NoError(t, slow.Initialize(context.Background(), &stroppy.StepContext{
+		GlobalConfig: &stroppy.Config{Run: &stroppy.RunConfig{Driver: &stroppy.DriverConfig{
+			Mock: &stroppy.MockDriverConfig{MinLatency: latency, MaxLatency: latency},
+		}}},
+	}))
+
+	start := time.Now()
+
+	results, err := Replay(context.Background(), driver.NewLocal(slow),
+		NewReader(bytes.NewReader(log.Bytes())), OriginalTiming)
+	require.NoError(t, err)
+
+	replayResults, err := errchan.Collect(results)
+	require.NoError(t, err)
+	require.Len(t, replayResults, 5)
+	require.Less(t, time.Since(start), 300*time.Millisecond)
+
+	for idx, result := range replayResults {
+		require.Equal(t, uint64(idx), result.GetId())
+		require.Empty(t, result.GetError())
+	}
+}
+
+func TestReader_Broken(t *testing.T) {
+	var log bytes.Buffer
+
+	writer := NewWriter(&log)
+	require.NoError(t, writer.Write(time.Now(), transaction("first", 1)))
+	require.NoError(t, writer.Write(time.Now(), transaction("truncated", 2)))
+	require.NoError(t, writer.Flush())
+
+	reader := NewReader(bytes.NewReader(log.Bytes()[:log.Len()-1]))
+
+	entry, err := reader.Next()
+	require.NoError(t, err)
+	require.Equal(t, "first", entry.GetTransaction().GetQueries()[0].GetName())
+
+	_, err = reader.Next()
+	require.Error(t, err)
+	require.NotErrorIs(t, err, io.EOF)
+
+	_, err = NewReader(&bytes.Buffer{}).Next()
+	require.ErrorIs(t, err, io.EOF)
+
+	for _, timing := range []Timing{AsFastAsPossible, OriginalTiming} {
+		results, err := Replay(context.Background(), driver.NewLocal(mock.New(queries.PlaceholderDollar)),
+			NewReader(bytes.NewReader(log.Bytes()[:log.Len()-1])), timing)
+		require.NoError(t, err)
+
+		_, err = errchan.Collect(results)
+		require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+	}
+}
@@ -2,22 +2,25 @@ package sidecar
 
 import (
 	"context"
-	"os"
-	"os/exec"
-	"strings"
+	"errors"
+	"io"
 
 	"github.com/hashicorp/go-plugin"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	"github.com/stroppy-io/stroppy-core/pkg/logger"
 	"github.com/stroppy-io/stroppy-core/pkg/plugins/common"
 	stroppy "github.com/stroppy-io/stroppy-core/pkg/proto"
+	"github.com/stroppy-io/stroppy-core/pkg/utils/errchan"
 )
 
 type client struct {
 	lg          *zap.Logger
 	protoClient stroppy.SidecarPluginClient
+	version     int
 }
 
 const driverClientLoggerName = "sidecar-plugin-client"
@@ -26,6 +29,7 @@ func newDriverClient(protoClient stroppy.SidecarPluginClient) *client {
 	return &client{
 		lg:          logger.NewStructLogger(driverClientLoggerName),
 		protoClient: protoClient,
+		version:     LatestPluginVersion,
 	}
 }
 
@@ -38,13 +42,19 @@ func (d *client) Initialize(
 	return err
 }
 
+// OnStepStart waits until the plugin lets the step start, see AwaitStepStart.
 func (d *client) OnStepStart(
 	ctx context.Context,
 	event *stroppy.StepContext,
 ) error {
-	_, err := d.protoClient.OnStepStart(ctx, event)
+	return AwaitStepStart(ctx, d, event, DefaultStepGateTimeout, d.lg)
+}
 
-	return err
+func (d *client) DecideStepStart(
+	ctx context.Context,
+	event *stroppy.StepContext,
+) (*stroppy.StepDecision, error) {
+	return d.protoClient.OnStepStart(ctx, event)
 }
 
 func (d *client) OnStepEnd(
@@ -56,38 +66,87 @@ func (d *client) OnStepEnd(
 	return err
 }
 
+// OnEvent sends event to the plugin when the negotiated protocol supports it, otherwise it is dropped.
+func (d *client) OnEvent(
+	ctx context.Context,
+	event *stroppy.SidecarEvent,
+) error {
+	if d.version < eventsPluginVersion {
+		return nil
+	}
+
+	_, err := d.protoClient.OnEvent(ctx, event)
+	if status.Code(err) == codes.Unimplemented {
+		return nil
+	}
+
+	return err
+}
+
+// Reports streams reports of the plugin when the negotiated protocol supports it,
+// otherwise the returned channel is closed.
+func (d *client) Reports(ctx context.Context) (errchan.Chan[stroppy.SidecarReport], error) {
+	if d.version < reportsPluginVersion {
+		return closedReports(), nil
+	}
+
+	stream, err := d.protoClient.Reports(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, err
+	}
+
+	channel := make(errchan.Chan[stroppy.SidecarReport])
+
+	go func() {
+		defer errchan.Close[stroppy.SidecarReport](channel)
+
+		for {
+			report, err := stream.Recv()
+			if errors.Is(err, io.EOF) || status.Code(err) == codes.Unimplemented {
+				return
+			}
+
+			if errchan.SendCtx(ctx, channel, report, err) != nil || err != nil {
+				return
+			}
+		}
+	}()
+
+	return channel, nil
+}
+
 func (d *client) Teardown(ctx context.Context) error {
 	_, err := d.protoClient.Teardown(ctx, &emptypb.Empty{})
 
 	return err
 }
 
+// ConnectToPlugin starts the sidecar described by pluginConfig,
+// runConfig provides logger settings of the plugin process, they are passed in its environment.
+// Output of the process is forwarded to lg.
 func ConnectToPlugin( //nolint: ireturn // need from lib
 	runConfig *stroppy.RunConfig,
+	pluginConfig *stroppy.Plugin,
 	lg *zap.Logger,
 ) (Plugin, context.CancelFunc, error) {
-	logger.SetLoggerEnv(
-		logger.LevelFromProtoConfig(runConfig.GetLogger().GetLogLevel()),
-		logger.ModeFromProtoConfig(runConfig.GetLogger().GetLogMode()),
-	)
-
-	clientPlugin := plugin.NewClient(&plugin.ClientConfig{
-		HandshakeConfig: PluginHandshake,
-		Plugins: map[string]plugin.Plugin{
-			PluginName: NewSharedPlugin(nil),
-		},
-		Cmd: exec.Command( //nolint: gosec // allow
-			"sh",
-			"-c",
-			runConfig.GetDriver().GetDriverPluginPath()+" "+
-				strings.Join(runConfig.GetDriver().GetDriverPluginArgs(), " "),
-		),
-		Logger:           common.NewLogger(lg.Named(driverClientLoggerName)),
+	source := common.LogSourceFromPlugin(runConfig.GetRunId(), pluginConfig)
+	command := common.CommandFromPlugin(pluginConfig).WithLogger(source.LoggerConfig(runConfig.GetLogger()))
+
+	clientConfig := &plugin.ClientConfig{
+		HandshakeConfig:  PluginHandshake,
+		VersionedPlugins: VersionedPlugins(nil),
+		Logger:           common.NewLogger(lg.Named(driverClientLoggerName)).Mute(source.Plugin),
 		AllowedProtocols: []plugin.Protocol{plugin.ProtocolGRPC},
-		Stderr:           os.Stderr,
-		SyncStderr:       os.Stderr,
-		SyncStdout:       os.Stdout,
-	})
+		Stderr:           common.NewLogForwarder(lg, source),
+		SyncStderr:       common.NewLogForwarder(lg, source),
+		SyncStdout:       common.NewLogForwarder(lg, source),
+	}
+
+	if err := command.ConfigureClient(clientConfig); err != nil {
+		return nil, func() {}, err
+	}
+
+	clientPlugin := plugin.NewClient(clientConfig)
 
 	rpcClient, err := clientPlugin.Client()
 	if err != nil {
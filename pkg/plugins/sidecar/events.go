@@ -0,0 +1,22 @@
+package sidecar
+
+import (
+	"context"
+
+	stroppy "github.com/stroppy-io/stroppy-core/pkg/proto"
+)
+
+// EventHandler may be implemented by Plugin to receive SidecarEvents about run and unit boundaries,
+// metric snapshots, errors and step results. Events are not delivered to plugins which do not implement it.
+type EventHandler interface {
+	OnEvent(ctx context.Context, event *stroppy.SidecarEvent) error
+}
+
+// SendEvent delivers event to plugin if it implements EventHandler.
+func SendEvent(ctx context.Context, plugin Plugin, event *stroppy.SidecarEvent) error {
+	if handler, ok := plugin.(EventHandler); ok {
+		return handler.OnEvent(ctx, event)
+	}
+
+	return nil
+}
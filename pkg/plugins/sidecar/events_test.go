@@ -0,0 +1,114 @@
+package sidecar
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+
+	stroppy "github.com/stroppy-io/stroppy-core/pkg/proto"
+)
+
+// silentSidecar does not handle events.
+type silentSidecar struct{}
+
+func (silentSidecar) Initialize(context.Context, *stroppy.StepContext) error  { return nil }
+func (silentSidecar) OnStepStart(context.Context, *stroppy.StepContext) error { return nil }
+func (silentSidecar) OnStepEnd(context.Context, *stroppy.StepContext) error   { return nil }
+func (silentSidecar) Teardown(context.Context) error                          { return nil }
+
+func dialTestServer(t *testing.T, impl Plugin) stroppy.SidecarPluginClient {
+	t.Helper()
+
+	return stroppy.NewSidecarPluginClient(dialVersionedTestServer(t, impl, LatestPluginVersion))
+}
+
+// dialVersionedTestServer serves impl speaking the protocol version negotiated with an older host.
+func dialVersionedTestServer(t *testing.T, impl Plugin, version int) *grpc.ClientConn {
+	t.Helper()
+
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer()
+	stroppy.RegisterSidecarPluginServer(server, newDriverServer(impl, version))
+
+	go func() {
+		_ = server.Serve(listener)
+	}()
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
+			return listener.Dial()
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		conn.Close()
+		server.Stop()
+		listener.Close()
+	})
+
+	return conn
+}
+
+func stepResultEvent() *stroppy.SidecarEvent {
+	return &stroppy.SidecarEvent{
+		Context: &stroppy.StepContext{Step: &stroppy.StepDescriptor{Name: "step"}},
+		Type: &stroppy.SidecarEvent_StepResult_{StepResult: &stroppy.SidecarEvent_StepResult{
+			Summary: &stroppy.ExecutionSummary{Transactions: 10, Errors: 1},
+		}},
+	}
+}
+
+func TestSendEvent(t *testing.T) {
+	require.NoError(t, SendEvent(context.Background(), silentSidecar{}, stepResultEvent()))
+
+	sidecar := &testSidecar{}
+	require.NoError(t, SendEvent(context.Background(), sidecar, stepResultEvent()))
+	require.Equal(t, []string{"event"}, sidecar.calls)
+}
+
+func TestClient_OnEvent(t *testing.T) {
+	tests := []struct {
+		name    string
+		impl    Plugin
+		version int
+		calls   []string
+	}{
+		{"handler", &testSidecar{}, LatestPluginVersion, []string{"event"}},
+		{"old protocol", &testSidecar{}, pluginVersion, nil},
+		{"not handler", silentSidecar{}, LatestPluginVersion, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sidecarClient := newDriverClient(dialTestServer(t, tt.impl))
+			sidecarClient.version = tt.version
+
+			require.NoError(t, sidecarClient.OnEvent(context.Background(), stepResultEvent()))
+
+			if sidecar, ok := tt.impl.(*testSidecar); ok {
+				require.Equal(t, tt.calls, sidecar.calls)
+			}
+		})
+	}
+
+	sidecarClient := newDriverClient(dialTestServer(t, &testSidecar{failOn: "event"}))
+	require.Error(t, sidecarClient.OnEvent(context.Background(), stepResultEvent()))
+}
+
+func TestVersionedPlugins(t *testing.T) {
+	sets := VersionedPlugins(nil)
+	require.Len(t, sets, LatestPluginVersion)
+
+	for version := pluginVersion; version <= LatestPluginVersion; version++ {
+		shared, ok := sets[version][PluginName].(*SharedPlugin)
+		require.True(t, ok)
+		require.Equal(t, version, shared.version)
+	}
+}
@@ -0,0 +1,113 @@
+package sidecar
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	stroppy "github.com/stroppy-io/stroppy-core/pkg/proto"
+)
+
+var (
+	ErrRunAborted      = errors.New("run aborted by sidecar")
+	ErrStepGateTimeout = errors.New("sidecar delayed step for too long")
+)
+
+const (
+	// DefaultStepGateTimeout limits delays of a step when Plugin.step_gate_timeout is unset.
+	DefaultStepGateTimeout = 10 * time.Minute
+	// DefaultPollInterval is used when a delay decision has no poll interval.
+	DefaultPollInterval = time.Second
+)
+
+// StepGate may be implemented by Plugin to hold steps until the database is ready or abort the run,
+// e.g. wait for vacuum or replication catch-up. It is asked instead of OnStepStart.
+type StepGate interface {
+	DecideStepStart(ctx context.Context, event *stroppy.StepContext) (*stroppy.StepDecision, error)
+}
+
+// DecideStepStart asks plugin whether the step may start if it implements StepGate,
+// otherwise it calls OnStepStart and proceeds.
+func DecideStepStart(
+	ctx context.Context,
+	plugin Plugin,
+	event *stroppy.StepContext,
+) (*stroppy.StepDecision, error) {
+	if gate, ok := plugin.(StepGate); ok {
+		return gate.DecideStepStart(ctx, event)
+	}
+
+	if err := plugin.OnStepStart(ctx, event); err != nil {
+		return nil, err
+	}
+
+	return &stroppy.StepDecision{Action: stroppy.StepDecision_ACTION_PROCEED}, nil
+}
+
+// AwaitStepStart asks plugin about the start of the step until it proceeds.
+// Delays are limited by timeout, DefaultStepGateTimeout if it is not positive. An abort decision
+// is returned as ErrRunAborted, a delay longer than timeout as ErrStepGateTimeout.
+func AwaitStepStart(
+	ctx context.Context,
+	plugin Plugin,
+	event *stroppy.StepContext,
+	timeout time.Duration,
+	lg *zap.Logger,
+) error {
+	if timeout <= 0 {
+		timeout = DefaultStepGateTimeout
+	}
+
+	deadline := time.Now().Add(timeout)
+
+	for {
+		decision, err := DecideStepStart(ctx, plugin, event)
+		if err != nil {
+			return err
+		}
+
+		switch decision.GetAction() {
+		case stroppy.StepDecision_ACTION_ABORT:
+			return fmt.Errorf("%w: %s", ErrRunAborted, decision.GetReason())
+		case stroppy.StepDecision_ACTION_DELAY:
+			remaining := time.Until(deadline)
+			if remaining <= 0 {
+				return fmt.Errorf("%w: %s: %s", ErrStepGateTimeout, timeout, decision.GetReason())
+			}
+
+			interval := decision.GetPollInterval().AsDuration()
+			if interval <= 0 {
+				interval = DefaultPollInterval
+			}
+
+			lg.Info("step start is delayed by sidecar",
+				zap.String("step", event.GetStep().GetName()),
+				zap.String("reason", decision.GetReason()),
+				zap.Duration("poll_interval", interval),
+			)
+
+			if err := sleep(ctx, min(interval, remaining)); err != nil {
+				return err
+			}
+		case stroppy.StepDecision_ACTION_PROCEED:
+			return nil
+		default:
+			return nil
+		}
+	}
+}
+
+func sleep(ctx context.Context, delay time.Duration) error {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,119 @@
+package sidecar
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	stroppy "github.com/stroppy-io/stroppy-core/pkg/proto"
+)
+
+func delay(interval time.Duration) *stroppy.StepDecision {
+	return &stroppy.StepDecision{
+		Action:       stroppy.StepDecision_ACTION_DELAY,
+		PollInterval: durationpb.New(interval),
+		Reason:       "replication lag",
+	}
+}
+
+func abort() *stroppy.StepDecision {
+	return &stroppy.StepDecision{Action: stroppy.StepDecision_ACTION_ABORT, Reason: "vacuum failed"}
+}
+
+func proceed() *stroppy.StepDecision {
+	return &stroppy.StepDecision{Action: stroppy.StepDecision_ACTION_PROCEED}
+}
+
+func TestAwaitStepStart(t *testing.T) {
+	event := &stroppy.StepContext{Step: &stroppy.StepDescriptor{Name: "step"}}
+
+	tests := []struct {
+		name      string
+		decisions []*stroppy.StepDecision
+		timeout   time.Duration
+		wantErr   error
+		wantCalls int // not checked if zero
+	}{
+		{"proceed", nil, time.Second, nil, 1},
+		{"delay then proceed", []*stroppy.StepDecision{delay(time.Millisecond), delay(time.Millisecond), proceed()},
+			time.Second, nil, 3},
+		{"abort", []*stroppy.StepDecision{delay(time.Millisecond), abort()}, time.Second, ErrRunAborted, 2},
+		{"timeout", []*stroppy.StepDecision{delay(10 * time.Millisecond)}, 25 * time.Millisecond, ErrStepGateTimeout, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sidecar := &testSidecar{decisions: tt.decisions}
+
+			err := AwaitStepStart(context.Background(), sidecar, event, tt.timeout, zap.NewNop())
+			require.ErrorIs(t, err, tt.wantErr)
+
+			if tt.wantCalls > 0 {
+				require.Len(t, sidecar.calls, tt.wantCalls)
+			}
+		})
+	}
+
+	require.NoError(t, AwaitStepStart(context.Background(), silentSidecar{}, event, 0, zap.NewNop()))
+}
+
+func TestClient_OnStepStart(t *testing.T) {
+	sidecar := &testSidecar{decisions: []*stroppy.StepDecision{delay(time.Millisecond), proceed()}}
+	sidecarClient := newDriverClient(dialTestServer(t, sidecar))
+
+	require.NoError(t, sidecarClient.OnStepStart(context.Background(), &stroppy.StepContext{}))
+	require.Equal(t, []string{"start", "start"}, sidecar.calls)
+
+	sidecarClient = newDriverClient(dialTestServer(t, &testSidecar{decisions: []*stroppy.StepDecision{abort()}}))
+	err := sidecarClient.OnStepStart(context.Background(), &stroppy.StepContext{})
+	require.ErrorIs(t, err, ErrRunAborted)
+	require.ErrorContains(t, err, "vacuum failed")
+
+	sidecarClient = newDriverClient(dialTestServer(t, silentSidecar{}))
+	require.NoError(t, sidecarClient.OnStepStart(context.Background(), &stroppy.StepContext{}))
+}
+
+func TestServer_OnStepStartOlderHost(t *testing.T) {
+	// Hosts before gatePluginVersion decode the reply of OnStepStart as Empty.
+	onStepStart := func(conn *grpc.ClientConn) error {
+		return conn.Invoke(context.Background(), stroppy.SidecarPlugin_OnStepStart_FullMethodName,
+			&stroppy.StepContext{}, &emptypb.Empty{})
+	}
+
+	for version := pluginVersion; version < gatePluginVersion; version++ {
+		sidecar := &testSidecar{decisions: []*stroppy.StepDecision{delay(time.Millisecond), proceed()}}
+		require.NoError(t, onStepStart(dialVersionedTestServer(t, sidecar, version)))
+		require.Equal(t, []string{"start", "start"}, sidecar.calls, "version %d", version)
+
+		err := onStepStart(dialVersionedTestServer(t, &testSidecar{decisions: []*stroppy.StepDecision{abort()}}, version))
+		require.Equal(t, codes.Aborted, status.Code(err), "version %d", version)
+		require.ErrorContains(t, err, ErrRunAborted.Error())
+		require.ErrorContains(t, err, "vacuum failed")
+	}
+}
+
+func TestManager_OnStepStartAbort(t *testing.T) {
+	connector := &testConnector{sidecars: map[string]*testSidecar{
+		"/bin/a": {decisions: []*stroppy.StepDecision{delay(time.Hour)}},
+		"/bin/b": {decisions: []*stroppy.StepDecision{abort()}},
+	}}
+
+	runConfig := testRunConfig()
+	runConfig.GetPlugins()[0].StepGateTimeout = durationpb.New(2 * time.Hour)
+
+	manager, err := newManager(runConfig, zap.NewNop(), connector.connect)
+	require.NoError(t, err)
+
+	start := time.Now()
+	err = manager.OnStepStart(context.Background(), &stroppy.StepContext{})
+	require.ErrorIs(t, err, ErrRunAborted)
+	require.Less(t, time.Since(start), time.Minute)
+}
@@ -0,0 +1,238 @@
+package sidecar
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/sourcegraph/conc/pool"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	stroppy "github.com/stroppy-io/stroppy-core/pkg/proto"
+	"github.com/stroppy-io/stroppy-core/pkg/utils/errchan"
+)
+
+type connectFunc func(
+	runConfig *stroppy.RunConfig,
+	pluginConfig *stroppy.Plugin,
+	lg *zap.Logger,
+) (Plugin, context.CancelFunc, error)
+
+type managedSidecar struct {
+	config *stroppy.Plugin
+	plugin Plugin
+	kill   context.CancelFunc
+}
+
+// Manager starts every TYPE_SIDECAR entry of RunConfig.Plugins and fans out calls to all of them.
+// Manager implements Plugin itself, so it can be used where a single sidecar is expected.
+type Manager struct {
+	lg       *zap.Logger
+	sidecars []*managedSidecar
+}
+
+var (
+	_ Plugin       = (*Manager)(nil)
+	_ EventHandler = (*Manager)(nil)
+	_ Reporter     = (*Manager)(nil)
+)
+
+// NewManager starts all sidecars of runConfig. If any of them fails to start,
+// already started ones are killed.
+func NewManager(runConfig *stroppy.RunConfig, lg *zap.Logger) (*Manager, error) {
+	return newManager(runConfig, lg, ConnectToPlugin)
+}
+
+func newManager(runConfig *stroppy.RunConfig, lg *zap.Logger, connect connectFunc) (*Manager, error) {
+	manager := &Manager{lg: lg}
+
+	for _, pluginConfig := range runConfig.GetPlugins() {
+		if pluginConfig.GetType() != stroppy.Plugin_TYPE_SIDECAR {
+			continue
+		}
+
+		sidecar, kill, err := connect(runConfig, pluginConfig, lg)
+		if err != nil {
+			if kill != nil {
+				kill()
+			}
+
+			manager.kill()
+
+			return nil, fmt.Errorf("failed to start sidecar '%s': %w", pluginConfig.GetPath(), err)
+		}
+
+		manager.sidecars = append(manager.sidecars, &managedSidecar{
+			config: pluginConfig,
+			plugin: sidecar,
+			kill:   kill,
+		})
+	}
+
+	return manager, nil
+}
+
+// Len returns the number of started sidecars.
+func (m *Manager) Len() int {
+	return len(m.sidecars)
+}
+
+// withSettings returns a copy of stepContext with PluginSettings of sidecar.
+func (s *managedSidecar) withSettings(stepContext *stroppy.StepContext) *stroppy.StepContext {
+	result := proto.CloneOf(stepContext)
+	if result == nil {
+		result = &stroppy.StepContext{}
+	}
+
+	result.PluginSettings = s.config.GetSettings()
+
+	return result
+}
+
+// fanOut calls fn for every sidecar concurrently and joins all errors.
+func (m *Manager) fanOut(
+	ctx context.Context,
+	action string,
+	fn func(ctx context.Context, sidecar *managedSidecar) error,
+) error {
+	tasks := pool.New().WithErrors().WithContext(ctx)
+
+	for _, sidecar := range m.sidecars {
+		tasks.Go(func(ctx context.Context) error {
+			if err := fn(ctx, sidecar); err != nil {
+				return fmt.Errorf("sidecar '%s' failed on %s: %w", sidecar.config.GetPath(), action, err)
+			}
+
+			return nil
+		})
+	}
+
+	return tasks.Wait()
+}
+
+// Initialize initializes every sidecar with its own Plugin.Settings in StepContext.PluginSettings.
+func (m *Manager) Initialize(ctx context.Context, runContext *stroppy.StepContext) error {
+	return m.fanOut(ctx, "initialize", func(ctx context.Context, sidecar *managedSidecar) error {
+		return sidecar.plugin.Initialize(ctx, sidecar.withSettings(runContext))
+	})
+}
+
+// OnStepStart waits until every sidecar lets the step start, each within its Plugin.step_gate_timeout.
+// Once a sidecar aborts the run or fails, the others are not waited for.
+func (m *Manager) OnStepStart(ctx context.Context, event *stroppy.StepContext) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	return m.fanOut(ctx, "step start", func(ctx context.Context, sidecar *managedSidecar) error {
+		err := AwaitStepStart(ctx, sidecar.plugin, sidecar.withSettings(event),
+			sidecar.config.GetStepGateTimeout().AsDuration(), m.lg)
+		if err != nil {
+			cancel()
+		}
+
+		return err
+	})
+}
+
+func (m *Manager) OnStepEnd(ctx context.Context, event *stroppy.StepContext) error {
+	return m.fanOut(ctx, "step end", func(ctx context.Context, sidecar *managedSidecar) error {
+		return sidecar.plugin.OnStepEnd(ctx, sidecar.withSettings(event))
+	})
+}
+
+// OnEvent delivers event to every sidecar which handles events, with its own Plugin.Settings
+// in the event context.
+func (m *Manager) OnEvent(ctx context.Context, event *stroppy.SidecarEvent) error {
+	return m.fanOut(ctx, "event", func(ctx context.Context, sidecar *managedSidecar) error {
+		sidecarEvent := proto.CloneOf(event)
+		if sidecarEvent == nil {
+			sidecarEvent = &stroppy.SidecarEvent{}
+		}
+
+		sidecarEvent.Context = sidecar.withSettings(event.GetContext())
+
+		return SendEvent(ctx, sidecar.plugin, sidecarEvent)
+	})
+}
+
+// Reports merges report streams of all sidecars, reports are marked with the path of their sidecar.
+// A failed stream of a sidecar is logged and does not stop the others,
+// the merged stream is closed when all of them are closed.
+func (m *Manager) Reports(ctx context.Context) (errchan.Chan[stroppy.SidecarReport], error) {
+	merged := make(errchan.Chan[stroppy.SidecarReport])
+
+	var wg sync.WaitGroup
+
+	for _, sidecar := range m.sidecars {
+		reports, err := Reports(ctx, sidecar.plugin)
+		if err != nil {
+			m.lg.Warn("sidecar reports are not available",
+				zap.String("sidecar", sidecar.config.GetPath()), zap.Error(err))
+
+			continue
+		}
+
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			m.forwardReports(ctx, sidecar, reports, merged)
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		errchan.Close[stroppy.SidecarReport](merged)
+	}()
+
+	return merged, nil
+}
+
+func (m *Manager) forwardReports(
+	ctx context.Context,
+	sidecar *managedSidecar,
+	reports errchan.Chan[stroppy.SidecarReport],
+	merged errchan.Chan[stroppy.SidecarReport],
+) {
+	for {
+		report, err := errchan.ReceiveCtx[stroppy.SidecarReport](ctx, reports)
+		if errors.Is(err, errchan.ErrReceiveClosed) || ctx.Err() != nil {
+			return
+		}
+
+		if err != nil {
+			m.lg.Warn("sidecar reports failed", zap.String("sidecar", sidecar.config.GetPath()), zap.Error(err))
+
+			return
+		}
+
+		report.Source = sidecar.config.GetPath()
+		if errchan.SendCtx(ctx, merged, report, nil) != nil {
+			return
+		}
+	}
+}
+
+// Teardown tears down all sidecars and kills their processes, even if some of them failed.
+func (m *Manager) Teardown(ctx context.Context) error {
+	err := m.fanOut(ctx, "teardown", func(ctx context.Context, sidecar *managedSidecar) error {
+		return sidecar.plugin.Teardown(ctx)
+	})
+
+	m.kill()
+
+	return err
+}
+
+func (m *Manager) kill() {
+	for _, sidecar := range m.sidecars {
+		if sidecar.kill != nil {
+			sidecar.kill()
+		}
+	}
+
+	m.sidecars = nil
+}
@@ -0,0 +1,197 @@
+package sidecar
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	stroppy "github.com/stroppy-io/stroppy-core/pkg/proto"
+	"github.com/stroppy-io/stroppy-core/pkg/utils/errchan"
+)
+
+var errTest = errors.New("test error")
+
+type testSidecar struct {
+	mu        sync.Mutex
+	calls     []string
+	settings  []*stroppy.Value_Struct
+	failOn    string
+	decisions []*stroppy.StepDecision
+	reports   []*stroppy.SidecarReport
+}
+
+func (s *testSidecar) record(call string, stepContext *stroppy.StepContext) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.calls = append(s.calls, call)
+	s.settings = append(s.settings, stepContext.GetPluginSettings())
+
+	if call == s.failOn {
+		return errTest
+	}
+
+	return nil
+}
+
+func (s *testSidecar) Initialize(_ context.Context, runContext *stroppy.StepContext) error {
+	return s.record("initialize", runContext)
+}
+
+func (s *testSidecar) OnStepStart(_ context.Context, event *stroppy.StepContext) error {
+	return s.record("start", event)
+}
+
+// DecideStepStart returns queued decisions one by one, then proceeds.
+func (s *testSidecar) DecideStepStart(_ context.Context, event *stroppy.StepContext) (*stroppy.StepDecision, error) {
+	if err := s.record("start", event); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.decisions) == 0 {
+		return &stroppy.StepDecision{}, nil
+	}
+
+	decision := s.decisions[0]
+	if len(s.decisions) > 1 {
+		s.decisions = s.decisions[1:]
+	}
+
+	return decision, nil
+}
+
+func (s *testSidecar) OnStepEnd(_ context.Context, event *stroppy.StepContext) error {
+	return s.record("end", event)
+}
+
+func (s *testSidecar) OnEvent(_ context.Context, event *stroppy.SidecarEvent) error {
+	return s.record("event", event.GetContext())
+}
+
+// Reports streams queued reports, the stream fails after them when failOn is "reports".
+func (s *testSidecar) Reports(context.Context) (errchan.Chan[stroppy.SidecarReport], error) {
+	channel := make(errchan.Chan[stroppy.SidecarReport], len(s.reports)+1)
+	for _, report := range s.reports {
+		errchan.Send(channel, report, nil)
+	}
+
+	if s.failOn == "reports" {
+		errchan.Send[stroppy.SidecarReport](channel, nil, errTest)
+	}
+
+	errchan.Close[stroppy.SidecarReport](channel)
+
+	return channel, nil
+}
+
+func (s *testSidecar) Teardown(context.Context) error {
+	return s.record("teardown", nil)
+}
+
+func settings(name string) *stroppy.Value_Struct {
+	return &stroppy.Value_Struct{Fields: []*stroppy.Value{{
+		Key:  "name",
+		Type: &stroppy.Value_String_{String_: name},
+	}}}
+}
+
+type testConnector struct {
+	sidecars map[string]*testSidecar
+	killed   []string
+	failPath string
+}
+
+func (c *testConnector) connect(
+	_ *stroppy.RunConfig,
+	pluginConfig *stroppy.Plugin,
+	_ *zap.Logger,
+) (Plugin, context.CancelFunc, error) {
+	path := pluginConfig.GetPath()
+	kill := func() { c.killed = append(c.killed, path) }
+
+	if path == c.failPath {
+		return nil, kill, errTest
+	}
+
+	sidecar := &testSidecar{
+		failOn:    c.sidecars[path].failOn,
+		decisions: c.sidecars[path].decisions,
+		reports:   c.sidecars[path].reports,
+	}
+	c.sidecars[path] = sidecar
+
+	return sidecar, kill, nil
+}
+
+func testRunConfig() *stroppy.RunConfig {
+	return &stroppy.RunConfig{
+		Plugins: []*stroppy.Plugin{
+			{Type: stroppy.Plugin_TYPE_SIDECAR, Path: "/bin/a", Settings: settings("a")},
+			{Type: stroppy.Plugin_TYPE_UNSPECIFIED, Path: "/bin/other"},
+			{Type: stroppy.Plugin_TYPE_SIDECAR, Path: "/bin/b", Settings: settings("b")},
+		},
+	}
+}
+
+func TestManager_FanOut(t *testing.T) {
+	connector := &testConnector{sidecars: map[string]*testSidecar{"/bin/a": {}, "/bin/b": {failOn: "end"}}}
+
+	manager, err := newManager(testRunConfig(), zap.NewNop(), connector.connect)
+	require.NoError(t, err)
+	require.Equal(t, 2, manager.Len())
+
+	ctx := context.Background()
+	stepContext := &stroppy.StepContext{Step: &stroppy.StepDescriptor{Name: "step"}}
+
+	require.NoError(t, manager.Initialize(ctx, stepContext))
+	require.NoError(t, manager.OnStepStart(ctx, stepContext))
+	require.ErrorIs(t, manager.OnStepEnd(ctx, stepContext), errTest)
+	require.NoError(t, manager.Teardown(ctx))
+	require.Nil(t, stepContext.GetPluginSettings())
+
+	for _, name := range []string{"a", "b"} {
+		sidecar := connector.sidecars["/bin/"+name]
+		require.Equal(t, []string{"initialize", "start", "end", "teardown"}, sidecar.calls)
+		require.Equal(t, "name", sidecar.settings[0].GetFields()[0].GetKey())
+		require.Equal(t, name, sidecar.settings[0].GetFields()[0].GetString_())
+	}
+
+	require.ElementsMatch(t, []string{"/bin/a", "/bin/b"}, connector.killed)
+	require.NotContains(t, connector.sidecars, "/bin/other")
+}
+
+func TestManager_ConnectFailureKillsStarted(t *testing.T) {
+	connector := &testConnector{sidecars: map[string]*testSidecar{"/bin/a": {}}, failPath: "/bin/b"}
+
+	_, err := newManager(testRunConfig(), zap.NewNop(), connector.connect)
+	require.ErrorIs(t, err, errTest)
+	require.ElementsMatch(t, []string{"/bin/a", "/bin/b"}, connector.killed)
+}
+
+func TestManager_OnEvent(t *testing.T) {
+	connector := &testConnector{sidecars: map[string]*testSidecar{"/bin/a": {}, "/bin/b": {failOn: "event"}}}
+
+	manager, err := newManager(testRunConfig(), zap.NewNop(), connector.connect)
+	require.NoError(t, err)
+
+	event := &stroppy.SidecarEvent{
+		Context: &stroppy.StepContext{Step: &stroppy.StepDescriptor{Name: "step"}},
+		Type:    &stroppy.SidecarEvent_RunStart_{RunStart: &stroppy.SidecarEvent_RunStart{}},
+	}
+
+	require.ErrorIs(t, manager.OnEvent(context.Background(), event), errTest)
+	require.Nil(t, event.GetContext().GetPluginSettings())
+
+	for _, name := range []string{"a", "b"} {
+		sidecar := connector.sidecars["/bin/"+name]
+		require.Equal(t, []string{"event"}, sidecar.calls)
+		require.Equal(t, name, sidecar.settings[0].GetFields()[0].GetString_())
+	}
+}
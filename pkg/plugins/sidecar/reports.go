@@ -0,0 +1,32 @@
+package sidecar
+
+import (
+	"context"
+
+	stroppy "github.com/stroppy-io/stroppy-core/pkg/proto"
+	"github.com/stroppy-io/stroppy-core/pkg/utils/errchan"
+)
+
+// Reporter may be implemented by Plugin to push metrics and annotations to the host,
+// e.g. database-side statistics to land in the same report as client-side latencies.
+// The host calls Reports once after Initialize and receives reports until the channel
+// is closed or ctx is canceled.
+type Reporter interface {
+	Reports(ctx context.Context) (errchan.Chan[stroppy.SidecarReport], error)
+}
+
+// Reports returns the report stream of plugin if it implements Reporter, otherwise a closed channel.
+func Reports(ctx context.Context, plugin Plugin) (errchan.Chan[stroppy.SidecarReport], error) {
+	if reporter, ok := plugin.(Reporter); ok {
+		return reporter.Reports(ctx)
+	}
+
+	return closedReports(), nil
+}
+
+func closedReports() errchan.Chan[stroppy.SidecarReport] {
+	channel := make(errchan.Chan[stroppy.SidecarReport])
+	errchan.Close[stroppy.SidecarReport](channel)
+
+	return channel
+}
@@ -0,0 +1,79 @@
+package sidecar
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	stroppy "github.com/stroppy-io/stroppy-core/pkg/proto"
+	"github.com/stroppy-io/stroppy-core/pkg/utils/errchan"
+)
+
+func metricReport(name string, value float64) *stroppy.SidecarReport {
+	return &stroppy.SidecarReport{
+		Metrics: []*stroppy.Metric{{Name: name, Labels: map[string]string{"db": "primary"}, Value: value}},
+	}
+}
+
+func TestClient_Reports(t *testing.T) {
+	reports := []*stroppy.SidecarReport{
+		metricReport("pg_locks", 3),
+		{Annotations: []*stroppy.Annotation{{Text: "checkpoint started"}}},
+	}
+
+	tests := []struct {
+		name    string
+		impl    Plugin
+		version int
+		want    int
+	}{
+		{"reporter", &testSidecar{reports: reports}, LatestPluginVersion, len(reports)},
+		{"old protocol", &testSidecar{reports: reports}, eventsPluginVersion, 0},
+		{"not reporter", silentSidecar{}, LatestPluginVersion, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sidecarClient := newDriverClient(dialTestServer(t, tt.impl))
+			sidecarClient.version = tt.version
+
+			channel, err := sidecarClient.Reports(context.Background())
+			require.NoError(t, err)
+
+			received, err := errchan.Collect(channel)
+			require.NoError(t, err)
+			require.Len(t, received, tt.want)
+		})
+	}
+
+	sidecarClient := newDriverClient(dialTestServer(t, &testSidecar{reports: reports, failOn: "reports"}))
+
+	channel, err := sidecarClient.Reports(context.Background())
+	require.NoError(t, err)
+
+	_, err = errchan.Collect(channel)
+	require.Error(t, err)
+}
+
+func TestManager_Reports(t *testing.T) {
+	connector := &testConnector{sidecars: map[string]*testSidecar{
+		"/bin/a": {reports: []*stroppy.SidecarReport{metricReport("a", 1), metricReport("a", 2)}},
+		"/bin/b": {reports: []*stroppy.SidecarReport{metricReport("b", 1)}, failOn: "reports"},
+	}}
+
+	manager, err := newManager(testRunConfig(), zap.NewNop(), connector.connect)
+	require.NoError(t, err)
+
+	channel, err := manager.Reports(context.Background())
+	require.NoError(t, err)
+
+	received, err := errchan.Collect(channel)
+	require.NoError(t, err)
+	require.Len(t, received, 3)
+
+	for _, report := range received {
+		require.Equal(t, "/bin/"+report.GetMetrics()[0].GetName(), report.GetSource())
+	}
+}
@@ -2,23 +2,36 @@ package sidecar
 
 import (
 	"context"
+	"errors"
 
 	"github.com/hashicorp/go-plugin"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 
 	"github.com/stroppy-io/stroppy-core/pkg/logger"
 	"github.com/stroppy-io/stroppy-core/pkg/plugins/common"
 	stroppy "github.com/stroppy-io/stroppy-core/pkg/proto"
+	"github.com/stroppy-io/stroppy-core/pkg/utils/errchan"
 )
 
+const sidecarServerLoggerName = "sidecar-plugin-server"
+
 type server struct {
 	impl Plugin
+	// version is the protocol version negotiated with the host.
+	version int
+	lg      *zap.Logger
 	*stroppy.UnimplementedSidecarPluginServer
 }
 
-func newDriverServer(impl Plugin) *server {
+func newDriverServer(impl Plugin, version int) *server {
 	return &server{
 		impl:                             impl,
+		version:                          version,
+		lg:                               logger.NewStructLogger(sidecarServerLoggerName),
 		UnimplementedSidecarPluginServer: &stroppy.UnimplementedSidecarPluginServer{},
 	}
 }
@@ -30,13 +43,26 @@ func (s server) Initialize(
 	return &emptypb.Empty{}, s.impl.Initialize(ctx, context)
 }
 
+// OnStepStart returns the decision of the plugin. Hosts of older protocol versions can not poll,
+// the step is held until the plugin proceeds then, an abort is returned as Aborted status.
 func (s server) OnStepStart(
 	ctx context.Context,
 	event *stroppy.StepContext,
-) (*emptypb.Empty, error) {
-	err := s.impl.OnStepStart(ctx, event)
+) (*stroppy.StepDecision, error) {
+	if s.version >= gatePluginVersion {
+		return DecideStepStart(ctx, s.impl, event)
+	}
 
-	return &emptypb.Empty{}, err
+	err := AwaitStepStart(ctx, s.impl, event, DefaultStepGateTimeout, s.lg)
+	if errors.Is(err, ErrRunAborted) {
+		return nil, status.Error(codes.Aborted, err.Error())
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &stroppy.StepDecision{Action: stroppy.StepDecision_ACTION_PROCEED}, nil
 }
 
 func (s server) OnStepEnd(
@@ -48,6 +74,38 @@ func (s server) OnStepEnd(
 	return &emptypb.Empty{}, err
 }
 
+func (s server) OnEvent(
+	ctx context.Context,
+	event *stroppy.SidecarEvent,
+) (*emptypb.Empty, error) {
+	return &emptypb.Empty{}, SendEvent(ctx, s.impl, event)
+}
+
+func (s server) Reports(
+	_ *emptypb.Empty,
+	stream grpc.ServerStreamingServer[stroppy.SidecarReport],
+) error {
+	reports, err := Reports(stream.Context(), s.impl)
+	if err != nil {
+		return err
+	}
+
+	for {
+		report, err := errchan.ReceiveCtx[stroppy.SidecarReport](stream.Context(), reports)
+		if errors.Is(err, errchan.ErrReceiveClosed) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if err := stream.Send(report); err != nil {
+			return err
+		}
+	}
+}
+
 func (s server) Teardown(
 	ctx context.Context,
 	_ *emptypb.Empty,
@@ -57,10 +115,8 @@ func (s server) Teardown(
 
 func ServePlugin(impl Plugin) {
 	plugin.Serve(&plugin.ServeConfig{
-		HandshakeConfig: PluginHandshake,
-		Plugins: map[string]plugin.Plugin{
-			PluginName: NewSharedPlugin(impl),
-		},
+		HandshakeConfig:  PluginHandshake,
+		VersionedPlugins: VersionedPlugins(impl),
 		// A non-nil value here enables gRPC serving for this plugin...
 		GRPCServer: plugin.DefaultGRPCServer,
 		Logger:     common.NewLogger(logger.NewFromEnv()),
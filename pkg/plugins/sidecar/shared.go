@@ -10,10 +10,21 @@ import (
 )
 
 const (
-	pluginVersion    = 1
-	magicCookieKey   = "stroppy_SIDECAR_PLUGIN"
-	magicCookieValue = "stroppy_SIDECAR_PLUGIN_HANDSHAKE"
-	PluginName       = "sidecar_grpc"
+	// pluginVersion is the base protocol version, hosts and plugins which
+	// do not negotiate VersionedPlugins speak it.
+	pluginVersion = 1
+	// eventsPluginVersion adds OnEvent RPC.
+	eventsPluginVersion = 2
+	// reportsPluginVersion adds Reports RPC.
+	reportsPluginVersion = 3
+	// gatePluginVersion makes OnStepStart reply with StepDecision, older hosts decode the reply as Empty,
+	// so the plugin waits for delays itself and reports aborts as error status.
+	gatePluginVersion = 4
+	// LatestPluginVersion is the newest protocol version supported by this package.
+	LatestPluginVersion = gatePluginVersion
+	magicCookieKey      = "stroppy_SIDECAR_PLUGIN"
+	magicCookieValue    = "stroppy_SIDECAR_PLUGIN_HANDSHAKE"
+	PluginName          = "sidecar_grpc"
 )
 
 var PluginHandshake = plugin.HandshakeConfig{ //nolint: gochecknoglobals // allow in shared
@@ -23,6 +34,8 @@ var PluginHandshake = plugin.HandshakeConfig{ //nolint: gochecknoglobals // allo
 	MagicCookieValue: magicCookieValue,
 }
 
+// Plugin is implemented by sidecars, they may also implement EventHandler to receive run events,
+// StepGate to hold steps and Reporter to push metrics to the host.
 type Plugin interface {
 	Initialize(ctx context.Context, runContext *stroppy.StepContext) error
 	OnStepStart(ctx context.Context, event *stroppy.StepContext) error
@@ -31,18 +44,39 @@ type Plugin interface {
 }
 type SharedPlugin struct {
 	plugin.Plugin
-	Impl Plugin
+	Impl    Plugin
+	version int
 }
 
 func NewSharedPlugin(impl Plugin) *SharedPlugin {
-	return &SharedPlugin{Impl: impl}
+	return &SharedPlugin{Impl: impl, version: LatestPluginVersion}
+}
+
+// VersionedPlugins returns plugin sets of every supported protocol version,
+// so a newer host can notify older sidecars and vice versa.
+func VersionedPlugins(impl Plugin) map[int]plugin.PluginSet {
+	sets := make(map[int]plugin.PluginSet, LatestPluginVersion)
+
+	for version := pluginVersion; version <= LatestPluginVersion; version++ {
+		sets[version] = plugin.PluginSet{
+			PluginName: &SharedPlugin{Impl: impl, version: version},
+		}
+	}
+
+	return sets
 }
 
 func (s SharedPlugin) GRPCServer(
 	_ *plugin.GRPCBroker,
 	g *grpc.Server,
 ) error {
-	stroppy.RegisterSidecarPluginServer(g, newDriverServer(s.Impl))
+	version := s.version
+	if version == 0 {
+		// Plugin sets without versions are negotiated with the handshake protocol version.
+		version = pluginVersion
+	}
+
+	stroppy.RegisterSidecarPluginServer(g, newDriverServer(s.Impl, version))
 
 	return nil
 }
@@ -52,5 +86,10 @@ func (s SharedPlugin) GRPCClient(
 	_ *plugin.GRPCBroker,
 	conn *grpc.ClientConn,
 ) (interface{}, error) {
-	return newDriverClient(stroppy.NewSidecarPluginClient(conn)), nil
+	sidecarClient := newDriverClient(stroppy.NewSidecarPluginClient(conn))
+	if s.version != 0 {
+		sidecarClient.version = s.version
+	}
+
+	return sidecarClient, nil
 }
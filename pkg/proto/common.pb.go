@@ -23,6 +23,72 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// *
+// DriverErrorClass defines how the host should treat a driver error.
+type DriverErrorClass int32
+
+const (
+	// * Error is not classified
+	DriverErrorClass_DRIVER_ERROR_CLASS_UNSPECIFIED DriverErrorClass = 0
+	// * Operation may succeed if retried, e.g. after serialization failure or deadlock
+	DriverErrorClass_DRIVER_ERROR_CLASS_RETRYABLE DriverErrorClass = 1
+	// * Transaction was aborted and should not be retried
+	DriverErrorClass_DRIVER_ERROR_CLASS_ABORTED DriverErrorClass = 2
+	// * Data violates database constraints
+	DriverErrorClass_DRIVER_ERROR_CLASS_CONSTRAINT DriverErrorClass = 3
+	// * Operation timed out
+	DriverErrorClass_DRIVER_ERROR_CLASS_TIMEOUT DriverErrorClass = 4
+	// * Operation can't succeed, e.g. because of wrong configuration or lost database
+	DriverErrorClass_DRIVER_ERROR_CLASS_FATAL DriverErrorClass = 5
+)
+
+// Enum value maps for DriverErrorClass.
+var (
+	DriverErrorClass_name = map[int32]string{
+		0: "DRIVER_ERROR_CLASS_UNSPECIFIED",
+		1: "DRIVER_ERROR_CLASS_RETRYABLE",
+		2: "DRIVER_ERROR_CLASS_ABORTED",
+		3: "DRIVER_ERROR_CLASS_CONSTRAINT",
+		4: "DRIVER_ERROR_CLASS_TIMEOUT",
+		5: "DRIVER_ERROR_CLASS_FATAL",
+	}
+	DriverErrorClass_value = map[string]int32{
+		"DRIVER_ERROR_CLASS_UNSPECIFIED": 0,
+		"DRIVER_ERROR_CLASS_RETRYABLE":   1,
+		"DRIVER_ERROR_CLASS_ABORTED":     2,
+		"DRIVER_ERROR_CLASS_CONSTRAINT":  3,
+		"DRIVER_ERROR_CLASS_TIMEOUT":     4,
+		"DRIVER_ERROR_CLASS_FATAL":       5,
+	}
+)
+
+func (x DriverErrorClass) Enum() *DriverErrorClass {
+	p := new(DriverErrorClass)
+	*p = x
+	return p
+}
+
+func (x DriverErrorClass) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DriverErrorClass) Descriptor() protoreflect.EnumDescriptor {
+	return file_common_proto_enumTypes[0].Descriptor()
+}
+
+func (DriverErrorClass) Type() protoreflect.EnumType {
+	return &file_common_proto_enumTypes[0]
+}
+
+func (x DriverErrorClass) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DriverErrorClass.Descriptor instead.
+func (DriverErrorClass) EnumDescriptor() ([]byte, []int) {
+	return file_common_proto_rawDescGZIP(), []int{0}
+}
+
 type Value_NullValue int32
 
 const (
@@ -51,11 +117,11 @@ func (x Value_NullValue) String() string {
 }
 
 func (Value_NullValue) Descriptor() protoreflect.EnumDescriptor {
-	return file_common_proto_enumTypes[0].Descriptor()
+	return file_common_proto_enumTypes[1].Descriptor()
 }
 
 func (Value_NullValue) Type() protoreflect.EnumType {
-	return &file_common_proto_enumTypes[0]
+	return &file_common_proto_enumTypes[1]
 }
 
 func (x Value_NullValue) Number() protoreflect.EnumNumber {
@@ -103,11 +169,11 @@ func (x Generation_Distribution_DistributionType) String() string {
 }
 
 func (Generation_Distribution_DistributionType) Descriptor() protoreflect.EnumDescriptor {
-	return file_common_proto_enumTypes[1].Descriptor()
+	return file_common_proto_enumTypes[2].Descriptor()
 }
 
 func (Generation_Distribution_DistributionType) Type() protoreflect.EnumType {
-	return &file_common_proto_enumTypes[1]
+	return &file_common_proto_enumTypes[2]
 }
 
 func (x Generation_Distribution_DistributionType) Number() protoreflect.EnumNumber {
@@ -2740,7 +2806,14 @@ const file_common_proto_rawDesc = "" +
 	"\x04type\x12\x03\xf8B\x01B\x0f\n" +
 	"\r_distributionB\x12\n" +
 	"\x10_null_percentageB\t\n" +
-	"\a_uniqueB.Z,github.com/stroppy-io/stroppy-core/pkg/protob\x06proto3"
+	"\a_unique*\xd9\x01\n" +
+	"\x10DriverErrorClass\x12\"\n" +
+	"\x1eDRIVER_ERROR_CLASS_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cDRIVER_ERROR_CLASS_RETRYABLE\x10\x01\x12\x1e\n" +
+	"\x1aDRIVER_ERROR_CLASS_ABORTED\x10\x02\x12!\n" +
+	"\x1dDRIVER_ERROR_CLASS_CONSTRAINT\x10\x03\x12\x1e\n" +
+	"\x1aDRIVER_ERROR_CLASS_TIMEOUT\x10\x04\x12\x1c\n" +
+	"\x18DRIVER_ERROR_CLASS_FATAL\x10\x05B.Z,github.com/stroppy-io/stroppy-core/pkg/protob\x06proto3"
 
 var (
 	file_common_proto_rawDescOnce sync.Once
@@ -2754,100 +2827,101 @@ func file_common_proto_rawDescGZIP() []byte {
 	return file_common_proto_rawDescData
 }
 
-var file_common_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_common_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
 var file_common_proto_msgTypes = make([]protoimpl.MessageInfo, 36)
 var file_common_proto_goTypes = []any{
-	(Value_NullValue)(0),                               // 0: stroppy.Value.NullValue
-	(Generation_Distribution_DistributionType)(0),      // 1: stroppy.Generation.Distribution.DistributionType
-	(*Decimal)(nil),                                    // 2: stroppy.Decimal
-	(*Uuid)(nil),                                       // 3: stroppy.Uuid
-	(*DateTime)(nil),                                   // 4: stroppy.DateTime
-	(*Value)(nil),                                      // 5: stroppy.Value
-	(*Generation)(nil),                                 // 6: stroppy.Generation
-	(*Value_List)(nil),                                 // 7: stroppy.Value.List
-	(*Value_Struct)(nil),                               // 8: stroppy.Value.Struct
-	(*Generation_Alphabet)(nil),                        // 9: stroppy.Generation.Alphabet
-	(*Generation_Distribution)(nil),                    // 10: stroppy.Generation.Distribution
-	(*Generation_Range)(nil),                           // 11: stroppy.Generation.Range
-	(*Generation_Rules)(nil),                           // 12: stroppy.Generation.Rules
-	(*Generation_Rule)(nil),                            // 13: stroppy.Generation.Rule
-	(*Generation_Range_AnyStringRange)(nil),            // 14: stroppy.Generation.Range.AnyStringRange
-	(*Generation_Range_FloatRange)(nil),                // 15: stroppy.Generation.Range.FloatRange
-	(*Generation_Range_DoubleRange)(nil),               // 16: stroppy.Generation.Range.DoubleRange
-	(*Generation_Range_Int32Range)(nil),                // 17: stroppy.Generation.Range.Int32Range
-	(*Generation_Range_Int64Range)(nil),                // 18: stroppy.Generation.Range.Int64Range
-	(*Generation_Range_UInt32Range)(nil),               // 19: stroppy.Generation.Range.UInt32Range
-	(*Generation_Range_UInt64Range)(nil),               // 20: stroppy.Generation.Range.UInt64Range
-	(*Generation_Range_DecimalRange)(nil),              // 21: stroppy.Generation.Range.DecimalRange
-	(*Generation_Range_DateTimeRange)(nil),             // 22: stroppy.Generation.Range.DateTimeRange
-	(*Generation_Range_DecimalRange_Default)(nil),      // 23: stroppy.Generation.Range.DecimalRange.Default
-	(*Generation_Range_DateTimeRange_Default)(nil),     // 24: stroppy.Generation.Range.DateTimeRange.Default
-	(*Generation_Range_DateTimeRange_TimestampPb)(nil), // 25: stroppy.Generation.Range.DateTimeRange.TimestampPb
-	(*Generation_Range_DateTimeRange_Timestamp)(nil),   // 26: stroppy.Generation.Range.DateTimeRange.Timestamp
-	(*Generation_Rules_FloatRule)(nil),                 // 27: stroppy.Generation.Rules.FloatRule
-	(*Generation_Rules_DoubleRule)(nil),                // 28: stroppy.Generation.Rules.DoubleRule
-	(*Generation_Rules_Int32Rule)(nil),                 // 29: stroppy.Generation.Rules.Int32Rule
-	(*Generation_Rules_Int64Rule)(nil),                 // 30: stroppy.Generation.Rules.Int64Rule
-	(*Generation_Rules_UInt32Rule)(nil),                // 31: stroppy.Generation.Rules.UInt32Rule
-	(*Generation_Rules_UInt64Rule)(nil),                // 32: stroppy.Generation.Rules.UInt64Rule
-	(*Generation_Rules_BoolRule)(nil),                  // 33: stroppy.Generation.Rules.BoolRule
-	(*Generation_Rules_StringRule)(nil),                // 34: stroppy.Generation.Rules.StringRule
-	(*Generation_Rules_DateTimeRule)(nil),              // 35: stroppy.Generation.Rules.DateTimeRule
-	(*Generation_Rules_UuidRule)(nil),                  // 36: stroppy.Generation.Rules.UuidRule
-	(*Generation_Rules_DecimalRule)(nil),               // 37: stroppy.Generation.Rules.DecimalRule
-	(*timestamppb.Timestamp)(nil),                      // 38: google.protobuf.Timestamp
+	(DriverErrorClass)(0),                              // 0: stroppy.DriverErrorClass
+	(Value_NullValue)(0),                               // 1: stroppy.Value.NullValue
+	(Generation_Distribution_DistributionType)(0),      // 2: stroppy.Generation.Distribution.DistributionType
+	(*Decimal)(nil),                                    // 3: stroppy.Decimal
+	(*Uuid)(nil),                                       // 4: stroppy.Uuid
+	(*DateTime)(nil),                                   // 5: stroppy.DateTime
+	(*Value)(nil),                                      // 6: stroppy.Value
+	(*Generation)(nil),                                 // 7: stroppy.Generation
+	(*Value_List)(nil),                                 // 8: stroppy.Value.List
+	(*Value_Struct)(nil),                               // 9: stroppy.Value.Struct
+	(*Generation_Alphabet)(nil),                        // 10: stroppy.Generation.Alphabet
+	(*Generation_Distribution)(nil),                    // 11: stroppy.Generation.Distribution
+	(*Generation_Range)(nil),                           // 12: stroppy.Generation.Range
+	(*Generation_Rules)(nil),                           // 13: stroppy.Generation.Rules
+	(*Generation_Rule)(nil),                            // 14: stroppy.Generation.Rule
+	(*Generation_Range_AnyStringRange)(nil),            // 15: stroppy.Generation.Range.AnyStringRange
+	(*Generation_Range_FloatRange)(nil),                // 16: stroppy.Generation.Range.FloatRange
+	(*Generation_Range_DoubleRange)(nil),               // 17: stroppy.Generation.Range.DoubleRange
+	(*Generation_Range_Int32Range)(nil),                // 18: stroppy.Generation.Range.Int32Range
+	(*Generation_Range_Int64Range)(nil),                // 19: stroppy.Generation.Range.Int64Range
+	(*Generation_Range_UInt32Range)(nil),               // 20: stroppy.Generation.Range.UInt32Range
+	(*Generation_Range_UInt64Range)(nil),               // 21: stroppy.Generation.Range.UInt64Range
+	(*Generation_Range_DecimalRange)(nil),              // 22: stroppy.Generation.Range.DecimalRange
+	(*Generation_Range_DateTimeRange)(nil),             // 23: stroppy.Generation.Range.DateTimeRange
+	(*Generation_Range_DecimalRange_Default)(nil),      // 24: stroppy.Generation.Range.DecimalRange.Default
+	(*Generation_Range_DateTimeRange_Default)(nil),     // 25: stroppy.Generation.Range.DateTimeRange.Default
+	(*Generation_Range_DateTimeRange_TimestampPb)(nil), // 26: stroppy.Generation.Range.DateTimeRange.TimestampPb
+	(*Generation_Range_DateTimeRange_Timestamp)(nil),   // 27: stroppy.Generation.Range.DateTimeRange.Timestamp
+	(*Generation_Rules_FloatRule)(nil),                 // 28: stroppy.Generation.Rules.FloatRule
+	(*Generation_Rules_DoubleRule)(nil),                // 29: stroppy.Generation.Rules.DoubleRule
+	(*Generation_Rules_Int32Rule)(nil),                 // 30: stroppy.Generation.Rules.Int32Rule
+	(*Generation_Rules_Int64Rule)(nil),                 // 31: stroppy.Generation.Rules.Int64Rule
+	(*Generation_Rules_UInt32Rule)(nil),                // 32: stroppy.Generation.Rules.UInt32Rule
+	(*Generation_Rules_UInt64Rule)(nil),                // 33: stroppy.Generation.Rules.UInt64Rule
+	(*Generation_Rules_BoolRule)(nil),                  // 34: stroppy.Generation.Rules.BoolRule
+	(*Generation_Rules_StringRule)(nil),                // 35: stroppy.Generation.Rules.StringRule
+	(*Generation_Rules_DateTimeRule)(nil),              // 36: stroppy.Generation.Rules.DateTimeRule
+	(*Generation_Rules_UuidRule)(nil),                  // 37: stroppy.Generation.Rules.UuidRule
+	(*Generation_Rules_DecimalRule)(nil),               // 38: stroppy.Generation.Rules.DecimalRule
+	(*timestamppb.Timestamp)(nil),                      // 39: google.protobuf.Timestamp
 }
 var file_common_proto_depIdxs = []int32{
-	38, // 0: stroppy.DateTime.value:type_name -> google.protobuf.Timestamp
-	0,  // 1: stroppy.Value.null:type_name -> stroppy.Value.NullValue
-	2,  // 2: stroppy.Value.decimal:type_name -> stroppy.Decimal
-	3,  // 3: stroppy.Value.uuid:type_name -> stroppy.Uuid
-	4,  // 4: stroppy.Value.datetime:type_name -> stroppy.DateTime
-	8,  // 5: stroppy.Value.struct:type_name -> stroppy.Value.Struct
-	7,  // 6: stroppy.Value.list:type_name -> stroppy.Value.List
-	5,  // 7: stroppy.Value.List.values:type_name -> stroppy.Value
-	5,  // 8: stroppy.Value.Struct.fields:type_name -> stroppy.Value
-	19, // 9: stroppy.Generation.Alphabet.ranges:type_name -> stroppy.Generation.Range.UInt32Range
-	1,  // 10: stroppy.Generation.Distribution.type:type_name -> stroppy.Generation.Distribution.DistributionType
-	27, // 11: stroppy.Generation.Rule.float_rules:type_name -> stroppy.Generation.Rules.FloatRule
-	28, // 12: stroppy.Generation.Rule.double_rules:type_name -> stroppy.Generation.Rules.DoubleRule
-	29, // 13: stroppy.Generation.Rule.int32_rules:type_name -> stroppy.Generation.Rules.Int32Rule
-	30, // 14: stroppy.Generation.Rule.int64_rules:type_name -> stroppy.Generation.Rules.Int64Rule
-	31, // 15: stroppy.Generation.Rule.uint32_rules:type_name -> stroppy.Generation.Rules.UInt32Rule
-	32, // 16: stroppy.Generation.Rule.uint64_rules:type_name -> stroppy.Generation.Rules.UInt64Rule
-	33, // 17: stroppy.Generation.Rule.bool_rules:type_name -> stroppy.Generation.Rules.BoolRule
-	34, // 18: stroppy.Generation.Rule.string_rules:type_name -> stroppy.Generation.Rules.StringRule
-	35, // 19: stroppy.Generation.Rule.datetime_rules:type_name -> stroppy.Generation.Rules.DateTimeRule
-	36, // 20: stroppy.Generation.Rule.uuid_rules:type_name -> stroppy.Generation.Rules.UuidRule
-	37, // 21: stroppy.Generation.Rule.decimal_rules:type_name -> stroppy.Generation.Rules.DecimalRule
-	10, // 22: stroppy.Generation.Rule.distribution:type_name -> stroppy.Generation.Distribution
-	23, // 23: stroppy.Generation.Range.DecimalRange.default:type_name -> stroppy.Generation.Range.DecimalRange.Default
-	15, // 24: stroppy.Generation.Range.DecimalRange.float:type_name -> stroppy.Generation.Range.FloatRange
-	16, // 25: stroppy.Generation.Range.DecimalRange.double:type_name -> stroppy.Generation.Range.DoubleRange
-	14, // 26: stroppy.Generation.Range.DecimalRange.string:type_name -> stroppy.Generation.Range.AnyStringRange
-	24, // 27: stroppy.Generation.Range.DateTimeRange.default:type_name -> stroppy.Generation.Range.DateTimeRange.Default
-	14, // 28: stroppy.Generation.Range.DateTimeRange.string:type_name -> stroppy.Generation.Range.AnyStringRange
-	25, // 29: stroppy.Generation.Range.DateTimeRange.timestamp_pb:type_name -> stroppy.Generation.Range.DateTimeRange.TimestampPb
-	26, // 30: stroppy.Generation.Range.DateTimeRange.timestamp:type_name -> stroppy.Generation.Range.DateTimeRange.Timestamp
-	2,  // 31: stroppy.Generation.Range.DecimalRange.Default.min:type_name -> stroppy.Decimal
-	2,  // 32: stroppy.Generation.Range.DecimalRange.Default.max:type_name -> stroppy.Decimal
-	4,  // 33: stroppy.Generation.Range.DateTimeRange.Default.min:type_name -> stroppy.DateTime
-	4,  // 34: stroppy.Generation.Range.DateTimeRange.Default.max:type_name -> stroppy.DateTime
-	38, // 35: stroppy.Generation.Range.DateTimeRange.TimestampPb.min:type_name -> google.protobuf.Timestamp
-	38, // 36: stroppy.Generation.Range.DateTimeRange.TimestampPb.max:type_name -> google.protobuf.Timestamp
-	15, // 37: stroppy.Generation.Rules.FloatRule.range:type_name -> stroppy.Generation.Range.FloatRange
-	16, // 38: stroppy.Generation.Rules.DoubleRule.range:type_name -> stroppy.Generation.Range.DoubleRange
-	17, // 39: stroppy.Generation.Rules.Int32Rule.range:type_name -> stroppy.Generation.Range.Int32Range
-	18, // 40: stroppy.Generation.Rules.Int64Rule.range:type_name -> stroppy.Generation.Range.Int64Range
-	19, // 41: stroppy.Generation.Rules.UInt32Rule.range:type_name -> stroppy.Generation.Range.UInt32Range
-	20, // 42: stroppy.Generation.Rules.UInt64Rule.range:type_name -> stroppy.Generation.Range.UInt64Range
-	9,  // 43: stroppy.Generation.Rules.StringRule.alphabet:type_name -> stroppy.Generation.Alphabet
-	20, // 44: stroppy.Generation.Rules.StringRule.len_range:type_name -> stroppy.Generation.Range.UInt64Range
-	22, // 45: stroppy.Generation.Rules.DateTimeRule.range:type_name -> stroppy.Generation.Range.DateTimeRange
-	4,  // 46: stroppy.Generation.Rules.DateTimeRule.constant:type_name -> stroppy.DateTime
-	3,  // 47: stroppy.Generation.Rules.UuidRule.constant:type_name -> stroppy.Uuid
-	21, // 48: stroppy.Generation.Rules.DecimalRule.range:type_name -> stroppy.Generation.Range.DecimalRange
-	2,  // 49: stroppy.Generation.Rules.DecimalRule.constant:type_name -> stroppy.Decimal
+	39, // 0: stroppy.DateTime.value:type_name -> google.protobuf.Timestamp
+	1,  // 1: stroppy.Value.null:type_name -> stroppy.Value.NullValue
+	3,  // 2: stroppy.Value.decimal:type_name -> stroppy.Decimal
+	4,  // 3: stroppy.Value.uuid:type_name -> stroppy.Uuid
+	5,  // 4: stroppy.Value.datetime:type_name -> stroppy.DateTime
+	9,  // 5: stroppy.Value.struct:type_name -> stroppy.Value.Struct
+	8,  // 6: stroppy.Value.list:type_name -> stroppy.Value.List
+	6,  // 7: stroppy.Value.List.values:type_name -> stroppy.Value
+	6,  // 8: stroppy.Value.Struct.fields:type_name -> stroppy.Value
+	20, // 9: stroppy.Generation.Alphabet.ranges:type_name -> stroppy.Generation.Range.UInt32Range
+	2,  // 10: stroppy.Generation.Distribution.type:type_name -> stroppy.Generation.Distribution.DistributionType
+	28, // 11: stroppy.Generation.Rule.float_rules:type_name -> stroppy.Generation.Rules.FloatRule
+	29, // 12: stroppy.Generation.Rule.double_rules:type_name -> stroppy.Generation.Rules.DoubleRule
+	30, // 13: stroppy.Generation.Rule.int32_rules:type_name -> stroppy.Generation.Rules.Int32Rule
+	31, // 14: stroppy.Generation.Rule.int64_rules:type_name -> stroppy.Generation.Rules.Int64Rule
+	32, // 15: stroppy.Generation.Rule.uint32_rules:type_name -> stroppy.Generation.Rules.UInt32Rule
+	33, // 16: stroppy.Generation.Rule.uint64_rules:type_name -> stroppy.Generation.Rules.UInt64Rule
+	34, // 17: stroppy.Generation.Rule.bool_rules:type_name -> stroppy.Generation.Rules.BoolRule
+	35, // 18: stroppy.Generation.Rule.string_rules:type_name -> stroppy.Generation.Rules.StringRule
+	36, // 19: stroppy.Generation.Rule.datetime_rules:type_name -> stroppy.Generation.Rules.DateTimeRule
+	37, // 20: stroppy.Generation.Rule.uuid_rules:type_name -> stroppy.Generation.Rules.UuidRule
+	38, // 21: stroppy.Generation.Rule.decimal_rules:type_name -> stroppy.Generation.Rules.DecimalRule
+	11, // 22: stroppy.Generation.Rule.distribution:type_name -> stroppy.Generation.Distribution
+	24, // 23: stroppy.Generation.Range.DecimalRange.default:type_name -> stroppy.Generation.Range.DecimalRange.Default
+	16, // 24: stroppy.Generation.Range.DecimalRange.float:type_name -> stroppy.Generation.Range.FloatRange
+	17, // 25: stroppy.Generation.Range.DecimalRange.double:type_name -> stroppy.Generation.Range.DoubleRange
+	15, // 26: stroppy.Generation.Range.DecimalRange.string:type_name -> stroppy.Generation.Range.AnyStringRange
+	25, // 27: stroppy.Generation.Range.DateTimeRange.default:type_name -> stroppy.Generation.Range.DateTimeRange.Default
+	15, // 28: stroppy.Generation.Range.DateTimeRange.string:type_name -> stroppy.Generation.Range.AnyStringRange
+	26, // 29: stroppy.Generation.Range.DateTimeRange.timestamp_pb:type_name -> stroppy.Generation.Range.DateTimeRange.TimestampPb
+	27, // 30: stroppy.Generation.Range.DateTimeRange.timestamp:type_name -> stroppy.Generation.Range.DateTimeRange.Timestamp
+	3,  // 31: stroppy.Generation.Range.DecimalRange.Default.min:type_name -> stroppy.Decimal
+	3,  // 32: stroppy.Generation.Range.DecimalRange.Default.max:type_name -> stroppy.Decimal
+	5,  // 33: stroppy.Generation.Range.DateTimeRange.Default.min:type_name -> stroppy.DateTime
+	5,  // 34: stroppy.Generation.Range.DateTimeRange.Default.max:type_name -> stroppy.DateTime
+	39, // 35: stroppy.Generation.Range.DateTimeRange.TimestampPb.min:type_name -> google.protobuf.Timestamp
+	39, // 36: stroppy.Generation.Range.DateTimeRange.TimestampPb.max:type_name -> google.protobuf.Timestamp
+	16, // 37: stroppy.Generation.Rules.FloatRule.range:type_name -> stroppy.Generation.Range.FloatRange
+	17, // 38: stroppy.Generation.Rules.DoubleRule.range:type_name -> stroppy.Generation.Range.DoubleRange
+	18, // 39: stroppy.Generation.Rules.Int32Rule.range:type_name -> stroppy.Generation.Range.Int32Range
+	19, // 40: stroppy.Generation.Rules.Int64Rule.range:type_name -> stroppy.Generation.Range.Int64Range
+	20, // 41: stroppy.Generation.Rules.UInt32Rule.range:type_name -> stroppy.Generation.Range.UInt32Range
+	21, // 42: stroppy.Generation.Rules.UInt64Rule.range:type_name -> stroppy.Generation.Range.UInt64Range
+	10, // 43: stroppy.Generation.Rules.StringRule.alphabet:type_name -> stroppy.Generation.Alphabet
+	21, // 44: stroppy.Generation.Rules.StringRule.len_range:type_name -> stroppy.Generation.Range.UInt64Range
+	23, // 45: stroppy.Generation.Rules.DateTimeRule.range:type_name -> stroppy.Generation.Range.DateTimeRange
+	5,  // 46: stroppy.Generation.Rules.DateTimeRule.constant:type_name -> stroppy.DateTime
+	4,  // 47: stroppy.Generation.Rules.UuidRule.constant:type_name -> stroppy.Uuid
+	22, // 48: stroppy.Generation.Rules.DecimalRule.range:type_name -> stroppy.Generation.Range.DecimalRange
+	3,  // 49: stroppy.Generation.Rules.DecimalRule.constant:type_name -> stroppy.Decimal
 	50, // [50:50] is the sub-list for method output_type
 	50, // [50:50] is the sub-list for method input_type
 	50, // [50:50] is the sub-list for extension type_name
@@ -2917,7 +2991,7 @@ func file_common_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_common_proto_rawDesc), len(file_common_proto_rawDesc)),
-			NumEnums:      2,
+			NumEnums:      3,
 			NumMessages:   36,
 			NumExtensions: 0,
 			NumServices:   0,
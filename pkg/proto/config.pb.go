@@ -66,7 +66,7 @@ func (x RequestedStep_ExecutorType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use RequestedStep_ExecutorType.Descriptor instead.
 func (RequestedStep_ExecutorType) EnumDescriptor() ([]byte, []int) {
-	return file_config_proto_rawDescGZIP(), []int{4, 0}
+	return file_config_proto_rawDescGZIP(), []int{10, 0}
 }
 
 type LoggerConfig_LogLevel int32
@@ -121,7 +121,7 @@ func (x LoggerConfig_LogLevel) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use LoggerConfig_LogLevel.Descriptor instead.
 func (LoggerConfig_LogLevel) EnumDescriptor() ([]byte, []int) {
-	return file_config_proto_rawDescGZIP(), []int{5, 0}
+	return file_config_proto_rawDescGZIP(), []int{11, 0}
 }
 
 type LoggerConfig_LogMode int32
@@ -167,7 +167,56 @@ func (x LoggerConfig_LogMode) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use LoggerConfig_LogMode.Descriptor instead.
 func (LoggerConfig_LogMode) EnumDescriptor() ([]byte, []int) {
-	return file_config_proto_rawDescGZIP(), []int{5, 1}
+	return file_config_proto_rawDescGZIP(), []int{11, 1}
+}
+
+type LoggerConfig_LogFormat int32
+
+const (
+	LoggerConfig_LOG_FORMAT_UNSPECIFIED LoggerConfig_LogFormat = 0
+	LoggerConfig_LOG_FORMAT_JSON        LoggerConfig_LogFormat = 1
+	LoggerConfig_LOG_FORMAT_CONSOLE     LoggerConfig_LogFormat = 2
+)
+
+// Enum value maps for LoggerConfig_LogFormat.
+var (
+	LoggerConfig_LogFormat_name = map[int32]string{
+		0: "LOG_FORMAT_UNSPECIFIED",
+		1: "LOG_FORMAT_JSON",
+		2: "LOG_FORMAT_CONSOLE",
+	}
+	LoggerConfig_LogFormat_value = map[string]int32{
+		"LOG_FORMAT_UNSPECIFIED": 0,
+		"LOG_FORMAT_JSON":        1,
+		"LOG_FORMAT_CONSOLE":     2,
+	}
+)
+
+func (x LoggerConfig_LogFormat) Enum() *LoggerConfig_LogFormat {
+	p := new(LoggerConfig_LogFormat)
+	*p = x
+	return p
+}
+
+func (x LoggerConfig_LogFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LoggerConfig_LogFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_config_proto_enumTypes[3].Descriptor()
+}
+
+func (LoggerConfig_LogFormat) Type() protoreflect.EnumType {
+	return &file_config_proto_enumTypes[3]
+}
+
+func (x LoggerConfig_LogFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LoggerConfig_LogFormat.Descriptor instead.
+func (LoggerConfig_LogFormat) EnumDescriptor() ([]byte, []int) {
+	return file_config_proto_rawDescGZIP(), []int{11, 2}
 }
 
 type Plugin_Type int32
@@ -200,11 +249,11 @@ func (x Plugin_Type) String() string {
 }
 
 func (Plugin_Type) Descriptor() protoreflect.EnumDescriptor {
-	return file_config_proto_enumTypes[3].Descriptor()
+	return file_config_proto_enumTypes[4].Descriptor()
 }
 
 func (Plugin_Type) Type() protoreflect.EnumType {
-	return &file_config_proto_enumTypes[3]
+	return &file_config_proto_enumTypes[4]
 }
 
 func (x Plugin_Type) Number() protoreflect.EnumNumber {
@@ -213,7 +262,7 @@ func (x Plugin_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Plugin_Type.Descriptor instead.
 func (Plugin_Type) EnumDescriptor() ([]byte, []int) {
-	return file_config_proto_rawDescGZIP(), []int{7, 0}
+	return file_config_proto_rawDescGZIP(), []int{13, 0}
 }
 
 // *
@@ -490,9 +539,31 @@ type DriverConfig struct {
 	// * Database connection URL
 	Url string `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
 	// * Database-specific configuration options
-	DbSpecific    *Value_Struct `protobuf:"bytes,4,opt,name=db_specific,json=dbSpecific,proto3,oneof" json:"db_specific,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	DbSpecific *Value_Struct `protobuf:"bytes,4,opt,name=db_specific,json=dbSpecific,proto3,oneof" json:"db_specific,omitempty"`
+	// * Additional environment variables for the driver plugin process
+	DriverPluginEnv map[string]string `protobuf:"bytes,5,rep,name=driver_plugin_env,json=driverPluginEnv,proto3" json:"driver_plugin_env,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// * Working directory of the driver plugin process, inherited if empty
+	DriverPluginWorkdir string `protobuf:"bytes,6,opt,name=driver_plugin_workdir,json=driverPluginWorkdir,proto3" json:"driver_plugin_workdir,omitempty"`
+	// * Hex encoded SHA-256 checksum of the driver plugin binary, verified before start if set
+	DriverPluginSha256 string `protobuf:"bytes,7,opt,name=driver_plugin_sha256,json=driverPluginSha256,proto3" json:"driver_plugin_sha256,omitempty"`
+	// * Policy of retrying failed transactions, transactions are not retried if unset
+	RetryPolicy *RetryPolicy `protobuf:"bytes,8,opt,name=retry_policy,json=retryPolicy,proto3,oneof" json:"retry_policy,omitempty"`
+	// * Name of a driver linked into the binary, runs it in-process instead of the plugin binary if set
+	DriverName string `protobuf:"bytes,9,opt,name=driver_name,json=driverName,proto3" json:"driver_name,omitempty"`
+	// * Interval of driver plugin health checks, health is not checked if unset
+	HealthCheckInterval *durationpb.Duration `protobuf:"bytes,10,opt,name=health_check_interval,json=healthCheckInterval,proto3,oneof" json:"health_check_interval,omitempty"`
+	// * Maximum number of automatic restarts of the driver plugin process after it died, 0 disables restarts
+	MaxRestarts uint32 `protobuf:"varint,11,opt,name=max_restarts,json=maxRestarts,proto3" json:"max_restarts,omitempty"`
+	// * Driver plugin server to connect over TCP instead of starting the plugin binary
+	Remote *RemoteDriverConfig `protobuf:"bytes,12,opt,name=remote,proto3,oneof" json:"remote,omitempty"`
+	// * Pre-started driver plugin to reattach to instead of starting the plugin binary
+	Reattach *ReattachConfig `protobuf:"bytes,13,opt,name=reattach,proto3,oneof" json:"reattach,omitempty"`
+	// * Settings of the mock driver which records transactions instead of running them
+	Mock *MockDriverConfig `protobuf:"bytes,14,opt,name=mock,proto3,oneof" json:"mock,omitempty"`
+	// * Minimum level of logs forwarded from the driver plugin process, all its logs are forwarded if unset
+	DriverPluginLogLevel *LoggerConfig_LogLevel `protobuf:"varint,15,opt,name=driver_plugin_log_level,json=driverPluginLogLevel,proto3,enum=stroppy.LoggerConfig_LogLevel,oneof" json:"driver_plugin_log_level,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
 func (x *DriverConfig) Reset() {
@@ -553,6 +624,538 @@ func (x *DriverConfig) GetDbSpecific() *Value_Struct {
 	return nil
 }
 
+func (x *DriverConfig) GetDriverPluginEnv() map[string]string {
+	if x != nil {
+		return x.DriverPluginEnv
+	}
+	return nil
+}
+
+func (x *DriverConfig) GetDriverPluginWorkdir() string {
+	if x != nil {
+		return x.DriverPluginWorkdir
+	}
+	return ""
+}
+
+func (x *DriverConfig) GetDriverPluginSha256() string {
+	if x != nil {
+		return x.DriverPluginSha256
+	}
+	return ""
+}
+
+func (x *DriverConfig) GetRetryPolicy() *RetryPolicy {
+	if x != nil {
+		return x.RetryPolicy
+	}
+	return nil
+}
+
+func (x *DriverConfig) GetDriverName() string {
+	if x != nil {
+		return x.DriverName
+	}
+	return ""
+}
+
+func (x *DriverConfig) GetHealthCheckInterval() *durationpb.Duration {
+	if x != nil {
+		return x.HealthCheckInterval
+	}
+	return nil
+}
+
+func (x *DriverConfig) GetMaxRestarts() uint32 {
+	if x != nil {
+		return x.MaxRestarts
+	}
+	return 0
+}
+
+func (x *DriverConfig) GetRemote() *RemoteDriverConfig {
+	if x != nil {
+		return x.Remote
+	}
+	return nil
+}
+
+func (x *DriverConfig) GetReattach() *ReattachConfig {
+	if x != nil {
+		return x.Reattach
+	}
+	return nil
+}
+
+func (x *DriverConfig) GetMock() *MockDriverConfig {
+	if x != nil {
+		return x.Mock
+	}
+	return nil
+}
+
+func (x *DriverConfig) GetDriverPluginLogLevel() LoggerConfig_LogLevel {
+	if x != nil && x.DriverPluginLogLevel != nil {
+		return *x.DriverPluginLogLevel
+	}
+	return LoggerConfig_LOG_LEVEL_DEBUG
+}
+
+// *
+// MockDriverConfig configures the mock driver used for dry runs: it records received transactions
+// and simulates their latency and failures without a database.
+type MockDriverConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// * File to append received transactions to as JSON lines, they are kept in memory if empty
+	RecordFile string `protobuf:"bytes,1,opt,name=record_file,json=recordFile,proto3" json:"record_file,omitempty"`
+	// * Minimum simulated latency of a transaction
+	MinLatency *durationpb.Duration `protobuf:"bytes,2,opt,name=min_latency,json=minLatency,proto3,oneof" json:"min_latency,omitempty"`
+	// * Maximum simulated latency of a transaction, latency is not simulated if unset
+	MaxLatency *durationpb.Duration `protobuf:"bytes,3,opt,name=max_latency,json=maxLatency,proto3,oneof" json:"max_latency,omitempty"`
+	// * Distribution of simulated latency between min_latency and max_latency
+	LatencyDistribution *Generation_Distribution `protobuf:"bytes,4,opt,name=latency_distribution,json=latencyDistribution,proto3,oneof" json:"latency_distribution,omitempty"`
+	// * Simulated failures of transactions
+	Errors        []*MockError `protobuf:"bytes,5,rep,name=errors,proto3" json:"errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MockDriverConfig) Reset() {
+	*x = MockDriverConfig{}
+	mi := &file_config_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MockDriverConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MockDriverConfig) ProtoMessage() {}
+
+func (x *MockDriverConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_config_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MockDriverConfig.ProtoReflect.Descriptor instead.
+func (*MockDriverConfig) Descriptor() ([]byte, []int) {
+	return file_config_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *MockDriverConfig) GetRecordFile() string {
+	if x != nil {
+		return x.RecordFile
+	}
+	return ""
+}
+
+func (x *MockDriverConfig) GetMinLatency() *durationpb.Duration {
+	if x != nil {
+		return x.MinLatency
+	}
+	return nil
+}
+
+func (x *MockDriverConfig) GetMaxLatency() *durationpb.Duration {
+	if x != nil {
+		return x.MaxLatency
+	}
+	return nil
+}
+
+func (x *MockDriverConfig) GetLatencyDistribution() *Generation_Distribution {
+	if x != nil {
+		return x.LatencyDistribution
+	}
+	return nil
+}
+
+func (x *MockDriverConfig) GetErrors() []*MockError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+// *
+// MockError describes a failure simulated by the mock driver.
+type MockError struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// * Probability of the failure per transaction, probabilities of all failures sum up to at most 1
+	Probability float64 `protobuf:"fixed64,1,opt,name=probability,proto3" json:"probability,omitempty"`
+	// * Class of the failure
+	Class DriverErrorClass `protobuf:"varint,2,opt,name=class,proto3,enum=stroppy.DriverErrorClass" json:"class,omitempty"`
+	// * Error message, derived from the class if empty
+	Message       string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MockError) Reset() {
+	*x = MockError{}
+	mi := &file_config_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MockError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MockError) ProtoMessage() {}
+
+func (x *MockError) ProtoReflect() protoreflect.Message {
+	mi := &file_config_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MockError.ProtoReflect.Descriptor instead.
+func (*MockError) Descriptor() ([]byte, []int) {
+	return file_config_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *MockError) GetProbability() float64 {
+	if x != nil {
+		return x.Probability
+	}
+	return 0
+}
+
+func (x *MockError) GetClass() DriverErrorClass {
+	if x != nil {
+		return x.Class
+	}
+	return DriverErrorClass_DRIVER_ERROR_CLASS_UNSPECIFIED
+}
+
+func (x *MockError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// *
+// RemoteDriverConfig describes a driver plugin served over TCP, e.g. on a machine close to the database.
+type RemoteDriverConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// * Address of the driver plugin server in host:port form
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// * TLS settings of the connection, required unless insecure is set
+	Tls *TlsConfig `protobuf:"bytes,2,opt,name=tls,proto3,oneof" json:"tls,omitempty"`
+	// * Allow plaintext connection if tls is unset, e.g. for a driver plugin on a trusted network
+	Insecure      bool `protobuf:"varint,3,opt,name=insecure,proto3" json:"insecure,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoteDriverConfig) Reset() {
+	*x = RemoteDriverConfig{}
+	mi := &file_config_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoteDriverConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoteDriverConfig) ProtoMessage() {}
+
+func (x *RemoteDriverConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_config_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoteDriverConfig.ProtoReflect.Descriptor instead.
+func (*RemoteDriverConfig) Descriptor() ([]byte, []int) {
+	return file_config_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RemoteDriverConfig) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *RemoteDriverConfig) GetTls() *TlsConfig {
+	if x != nil {
+		return x.Tls
+	}
+	return nil
+}
+
+func (x *RemoteDriverConfig) GetInsecure() bool {
+	if x != nil {
+		return x.Insecure
+	}
+	return false
+}
+
+// *
+// TlsConfig defines TLS settings, mutual TLS is used if both certificate and CA are set.
+type TlsConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// * Path to PEM encoded CA certificates to verify the peer, system pool is used by clients if empty
+	CaFile string `protobuf:"bytes,1,opt,name=ca_file,json=caFile,proto3" json:"ca_file,omitempty"`
+	// * Path to PEM encoded certificate presented to the peer
+	CertFile string `protobuf:"bytes,2,opt,name=cert_file,json=certFile,proto3" json:"cert_file,omitempty"`
+	// * Path to PEM encoded private key of the certificate
+	KeyFile string `protobuf:"bytes,3,opt,name=key_file,json=keyFile,proto3" json:"key_file,omitempty"`
+	// * Server name to verify the server certificate against, host of the address is used if empty
+	ServerName    string `protobuf:"bytes,4,opt,name=server_name,json=serverName,proto3" json:"server_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TlsConfig) Reset() {
+	*x = TlsConfig{}
+	mi := &file_config_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TlsConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TlsConfig) ProtoMessage() {}
+
+func (x *TlsConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_config_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TlsConfig.ProtoReflect.Descriptor instead.
+func (*TlsConfig) Descriptor() ([]byte, []int) {
+	return file_config_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *TlsConfig) GetCaFile() string {
+	if x != nil {
+		return x.CaFile
+	}
+	return ""
+}
+
+func (x *TlsConfig) GetCertFile() string {
+	if x != nil {
+		return x.CertFile
+	}
+	return ""
+}
+
+func (x *TlsConfig) GetKeyFile() string {
+	if x != nil {
+		return x.KeyFile
+	}
+	return ""
+}
+
+func (x *TlsConfig) GetServerName() string {
+	if x != nil {
+		return x.ServerName
+	}
+	return ""
+}
+
+// *
+// ReattachConfig describes a running driver plugin served with driver.ServeReattachable.
+type ReattachConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// * Network of the plugin address, "unix" or "tcp"
+	Network string `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
+	// * Address the plugin listens on
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// * Process id of the plugin
+	Pid int32 `protobuf:"varint,3,opt,name=pid,proto3" json:"pid,omitempty"`
+	// * Negotiated protocol version, the latest one is used if unset
+	ProtocolVersion uint32 `protobuf:"varint,4,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ReattachConfig) Reset() {
+	*x = ReattachConfig{}
+	mi := &file_config_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReattachConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReattachConfig) ProtoMessage() {}
+
+func (x *ReattachConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_config_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReattachConfig.ProtoReflect.Descriptor instead.
+func (*ReattachConfig) Descriptor() ([]byte, []int) {
+	return file_config_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ReattachConfig) GetNetwork() string {
+	if x != nil {
+		return x.Network
+	}
+	return ""
+}
+
+func (x *ReattachConfig) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *ReattachConfig) GetPid() int32 {
+	if x != nil {
+		return x.Pid
+	}
+	return 0
+}
+
+func (x *ReattachConfig) GetProtocolVersion() uint32 {
+	if x != nil {
+		return x.ProtocolVersion
+	}
+	return 0
+}
+
+// *
+// RetryPolicy defines how failed transactions are retried by the core.
+// Delay before n-th retry is initial_backoff * backoff_multiplier^(n-1) limited by max_backoff.
+type RetryPolicy struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// * Maximum number of attempts including the first one, 0 and 1 disable retries
+	MaxAttempts uint32 `protobuf:"varint,1,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`
+	// * Delay before the first retry, 10ms if unset
+	InitialBackoff *durationpb.Duration `protobuf:"bytes,2,opt,name=initial_backoff,json=initialBackoff,proto3,oneof" json:"initial_backoff,omitempty"`
+	// * Maximum delay between retries, 1s if unset
+	MaxBackoff *durationpb.Duration `protobuf:"bytes,3,opt,name=max_backoff,json=maxBackoff,proto3,oneof" json:"max_backoff,omitempty"`
+	// * Multiplier of the delay after each retry, 2 if unset
+	BackoffMultiplier *float64 `protobuf:"fixed64,4,opt,name=backoff_multiplier,json=backoffMultiplier,proto3,oneof" json:"backoff_multiplier,omitempty"`
+	// * Fraction of the delay randomized to spread retries of concurrent transactions
+	Jitter float64 `protobuf:"fixed64,5,opt,name=jitter,proto3" json:"jitter,omitempty"`
+	// * Classes of errors to retry, only retryable errors are retried if empty
+	RetryClasses  []DriverErrorClass `protobuf:"varint,6,rep,packed,name=retry_classes,json=retryClasses,proto3,enum=stroppy.DriverErrorClass" json:"retry_classes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetryPolicy) Reset() {
+	*x = RetryPolicy{}
+	mi := &file_config_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetryPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryPolicy) ProtoMessage() {}
+
+func (x *RetryPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_config_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryPolicy.ProtoReflect.Descriptor instead.
+func (*RetryPolicy) Descriptor() ([]byte, []int) {
+	return file_config_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RetryPolicy) GetMaxAttempts() uint32 {
+	if x != nil {
+		return x.MaxAttempts
+	}
+	return 0
+}
+
+func (x *RetryPolicy) GetInitialBackoff() *durationpb.Duration {
+	if x != nil {
+		return x.InitialBackoff
+	}
+	return nil
+}
+
+func (x *RetryPolicy) GetMaxBackoff() *durationpb.Duration {
+	if x != nil {
+		return x.MaxBackoff
+	}
+	return nil
+}
+
+func (x *RetryPolicy) GetBackoffMultiplier() float64 {
+	if x != nil && x.BackoffMultiplier != nil {
+		return *x.BackoffMultiplier
+	}
+	return 0
+}
+
+func (x *RetryPolicy) GetJitter() float64 {
+	if x != nil {
+		return x.Jitter
+	}
+	return 0
+}
+
+func (x *RetryPolicy) GetRetryClasses() []DriverErrorClass {
+	if x != nil {
+		return x.RetryClasses
+	}
+	return nil
+}
+
 // *
 // RequestedStep defines a step that should be executed during the benchmark.
 // It specifies the step name and the type of executor to use.
@@ -568,7 +1171,7 @@ type RequestedStep struct {
 
 func (x *RequestedStep) Reset() {
 	*x = RequestedStep{}
-	mi := &file_config_proto_msgTypes[4]
+	mi := &file_config_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -580,7 +1183,7 @@ func (x *RequestedStep) String() string {
 func (*RequestedStep) ProtoMessage() {}
 
 func (x *RequestedStep) ProtoReflect() protoreflect.Message {
-	mi := &file_config_proto_msgTypes[4]
+	mi := &file_config_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -593,7 +1196,7 @@ func (x *RequestedStep) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RequestedStep.ProtoReflect.Descriptor instead.
 func (*RequestedStep) Descriptor() ([]byte, []int) {
-	return file_config_proto_rawDescGZIP(), []int{4}
+	return file_config_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *RequestedStep) GetName() string {
@@ -618,14 +1221,20 @@ type LoggerConfig struct {
 	// * Minimum log level to output
 	LogLevel LoggerConfig_LogLevel `protobuf:"varint,1,opt,name=log_level,json=logLevel,proto3,enum=stroppy.LoggerConfig_LogLevel" json:"log_level,omitempty"`
 	// * Logging mode (development or production)
-	LogMode       LoggerConfig_LogMode `protobuf:"varint,2,opt,name=log_mode,json=logMode,proto3,enum=stroppy.LoggerConfig_LogMode" json:"log_mode,omitempty"`
+	LogMode LoggerConfig_LogMode `protobuf:"varint,2,opt,name=log_mode,json=logMode,proto3,enum=stroppy.LoggerConfig_LogMode" json:"log_mode,omitempty"`
+	// * Encoding of log entries, JSON in production and console in development mode if unspecified
+	LogFormat LoggerConfig_LogFormat `protobuf:"varint,3,opt,name=log_format,json=logFormat,proto3,enum=stroppy.LoggerConfig_LogFormat" json:"log_format,omitempty"`
+	// * Sampling of repeated log entries, the default of the logging mode if unset
+	Sampling *LoggerConfig_Sampling `protobuf:"bytes,4,opt,name=sampling,proto3,oneof" json:"sampling,omitempty"`
+	// * Paths or URLs to write logs to, stderr if empty
+	OutputPaths   []string `protobuf:"bytes,5,rep,name=output_paths,json=outputPaths,proto3" json:"output_paths,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *LoggerConfig) Reset() {
 	*x = LoggerConfig{}
-	mi := &file_config_proto_msgTypes[5]
+	mi := &file_config_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -637,7 +1246,7 @@ func (x *LoggerConfig) String() string {
 func (*LoggerConfig) ProtoMessage() {}
 
 func (x *LoggerConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_config_proto_msgTypes[5]
+	mi := &file_config_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -650,7 +1259,7 @@ func (x *LoggerConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LoggerConfig.ProtoReflect.Descriptor instead.
 func (*LoggerConfig) Descriptor() ([]byte, []int) {
-	return file_config_proto_rawDescGZIP(), []int{5}
+	return file_config_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *LoggerConfig) GetLogLevel() LoggerConfig_LogLevel {
@@ -667,6 +1276,27 @@ func (x *LoggerConfig) GetLogMode() LoggerConfig_LogMode {
 	return LoggerConfig_LOG_MODE_DEVELOPMENT
 }
 
+func (x *LoggerConfig) GetLogFormat() LoggerConfig_LogFormat {
+	if x != nil {
+		return x.LogFormat
+	}
+	return LoggerConfig_LOG_FORMAT_UNSPECIFIED
+}
+
+func (x *LoggerConfig) GetSampling() *LoggerConfig_Sampling {
+	if x != nil {
+		return x.Sampling
+	}
+	return nil
+}
+
+func (x *LoggerConfig) GetOutputPaths() []string {
+	if x != nil {
+		return x.OutputPaths
+	}
+	return nil
+}
+
 // *
 // StepContext provides contextual information to a benchmark step during execution.
 // It contains the current configuration and descriptors relevant to the step.
@@ -675,14 +1305,16 @@ type StepContext struct {
 	// * Current step descriptor
 	Step *StepDescriptor `protobuf:"bytes,5,opt,name=step,proto3" json:"step,omitempty"`
 	// * Global configuration of the benchmark and its steps
-	GlobalConfig  *Config `protobuf:"bytes,6,opt,name=global_config,json=globalConfig,proto3" json:"global_config,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	GlobalConfig *Config `protobuf:"bytes,6,opt,name=global_config,json=globalConfig,proto3" json:"global_config,omitempty"`
+	// * Settings of the plugin receiving the context, taken from its Plugin entry
+	PluginSettings *Value_Struct `protobuf:"bytes,7,opt,name=plugin_settings,json=pluginSettings,proto3,oneof" json:"plugin_settings,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *StepContext) Reset() {
 	*x = StepContext{}
-	mi := &file_config_proto_msgTypes[6]
+	mi := &file_config_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -694,7 +1326,7 @@ func (x *StepContext) String() string {
 func (*StepContext) ProtoMessage() {}
 
 func (x *StepContext) ProtoReflect() protoreflect.Message {
-	mi := &file_config_proto_msgTypes[6]
+	mi := &file_config_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -707,7 +1339,7 @@ func (x *StepContext) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StepContext.ProtoReflect.Descriptor instead.
 func (*StepContext) Descriptor() ([]byte, []int) {
-	return file_config_proto_rawDescGZIP(), []int{6}
+	return file_config_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *StepContext) GetStep() *StepDescriptor {
@@ -724,6 +1356,13 @@ func (x *StepContext) GetGlobalConfig() *Config {
 	return nil
 }
 
+func (x *StepContext) GetPluginSettings() *Value_Struct {
+	if x != nil {
+		return x.PluginSettings
+	}
+	return nil
+}
+
 // *
 // Plugins contains configuration for plugins.
 type Plugin struct {
@@ -733,14 +1372,26 @@ type Plugin struct {
 	// * Path to the plugin binary
 	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
 	// * Additional plugin settings
-	Settings      *Value_Struct `protobuf:"bytes,3,opt,name=settings,proto3,oneof" json:"settings,omitempty"`
+	Settings *Value_Struct `protobuf:"bytes,3,opt,name=settings,proto3,oneof" json:"settings,omitempty"`
+	// * Additional arguments for the plugin
+	Args []string `protobuf:"bytes,4,rep,name=args,proto3" json:"args,omitempty"`
+	// * Additional environment variables for the plugin process
+	Env map[string]string `protobuf:"bytes,5,rep,name=env,proto3" json:"env,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// * Working directory of the plugin process, inherited if empty
+	Workdir string `protobuf:"bytes,6,opt,name=workdir,proto3" json:"workdir,omitempty"`
+	// * Hex encoded SHA-256 checksum of the plugin binary, verified before start if set
+	Sha256 string `protobuf:"bytes,7,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	// * Maximum time the sidecar may delay the start of a step, 10 minutes if unset
+	StepGateTimeout *durationpb.Duration `protobuf:"bytes,8,opt,name=step_gate_timeout,json=stepGateTimeout,proto3,oneof" json:"step_gate_timeout,omitempty"`
+	// * Minimum level of logs forwarded from the plugin process, all its logs are forwarded if unset
+	LogLevel      *LoggerConfig_LogLevel `protobuf:"varint,9,opt,name=log_level,json=logLevel,proto3,enum=stroppy.LoggerConfig_LogLevel,oneof" json:"log_level,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Plugin) Reset() {
 	*x = Plugin{}
-	mi := &file_config_proto_msgTypes[7]
+	mi := &file_config_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -752,7 +1403,7 @@ func (x *Plugin) String() string {
 func (*Plugin) ProtoMessage() {}
 
 func (x *Plugin) ProtoReflect() protoreflect.Message {
-	mi := &file_config_proto_msgTypes[7]
+	mi := &file_config_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -765,7 +1416,7 @@ func (x *Plugin) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Plugin.ProtoReflect.Descriptor instead.
 func (*Plugin) Descriptor() ([]byte, []int) {
-	return file_config_proto_rawDescGZIP(), []int{7}
+	return file_config_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *Plugin) GetType() Plugin_Type {
@@ -789,6 +1440,48 @@ func (x *Plugin) GetSettings() *Value_Struct {
 	return nil
 }
 
+func (x *Plugin) GetArgs() []string {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+func (x *Plugin) GetEnv() map[string]string {
+	if x != nil {
+		return x.Env
+	}
+	return nil
+}
+
+func (x *Plugin) GetWorkdir() string {
+	if x != nil {
+		return x.Workdir
+	}
+	return ""
+}
+
+func (x *Plugin) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+func (x *Plugin) GetStepGateTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.StepGateTimeout
+	}
+	return nil
+}
+
+func (x *Plugin) GetLogLevel() LoggerConfig_LogLevel {
+	if x != nil && x.LogLevel != nil {
+		return *x.LogLevel
+	}
+	return LoggerConfig_LOG_LEVEL_DEBUG
+}
+
 // *
 // RunConfig contains the complete configuration for a benchmark run.
 type RunConfig struct {
@@ -817,7 +1510,7 @@ type RunConfig struct {
 
 func (x *RunConfig) Reset() {
 	*x = RunConfig{}
-	mi := &file_config_proto_msgTypes[8]
+	mi := &file_config_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -829,7 +1522,7 @@ func (x *RunConfig) String() string {
 func (*RunConfig) ProtoMessage() {}
 
 func (x *RunConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_config_proto_msgTypes[8]
+	mi := &file_config_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -842,7 +1535,7 @@ func (x *RunConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RunConfig.ProtoReflect.Descriptor instead.
 func (*RunConfig) Descriptor() ([]byte, []int) {
-	return file_config_proto_rawDescGZIP(), []int{8}
+	return file_config_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *RunConfig) GetRunId() string {
@@ -927,7 +1620,7 @@ type Config struct {
 
 func (x *Config) Reset() {
 	*x = Config{}
-	mi := &file_config_proto_msgTypes[9]
+	mi := &file_config_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -939,7 +1632,7 @@ func (x *Config) String() string {
 func (*Config) ProtoMessage() {}
 
 func (x *Config) ProtoReflect() protoreflect.Message {
-	mi := &file_config_proto_msgTypes[9]
+	mi := &file_config_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -952,7 +1645,7 @@ func (x *Config) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Config.ProtoReflect.Descriptor instead.
 func (*Config) Descriptor() ([]byte, []int) {
-	return file_config_proto_rawDescGZIP(), []int{9}
+	return file_config_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *Config) GetVersion() string {
@@ -976,6 +1669,63 @@ func (x *Config) GetBenchmark() *BenchmarkDescriptor {
 	return nil
 }
 
+// *
+// Sampling limits entries with the same level and message logged each second,
+// it is disabled if both fields are 0.
+type LoggerConfig_Sampling struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// * Number of entries logged before sampling starts
+	Initial uint32 `protobuf:"varint,1,opt,name=initial,proto3" json:"initial,omitempty"`
+	// * Every thereafter-th entry is logged after the initial ones
+	Thereafter    uint32 `protobuf:"varint,2,opt,name=thereafter,proto3" json:"thereafter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoggerConfig_Sampling) Reset() {
+	*x = LoggerConfig_Sampling{}
+	mi := &file_config_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoggerConfig_Sampling) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoggerConfig_Sampling) ProtoMessage() {}
+
+func (x *LoggerConfig_Sampling) ProtoReflect() protoreflect.Message {
+	mi := &file_config_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoggerConfig_Sampling.ProtoReflect.Descriptor instead.
+func (*LoggerConfig_Sampling) Descriptor() ([]byte, []int) {
+	return file_config_proto_rawDescGZIP(), []int{11, 0}
+}
+
+func (x *LoggerConfig_Sampling) GetInitial() uint32 {
+	if x != nil {
+		return x.Initial
+	}
+	return 0
+}
+
+func (x *LoggerConfig_Sampling) GetThereafter() uint32 {
+	if x != nil {
+		return x.Thereafter
+	}
+	return 0
+}
+
 var File_config_proto protoreflect.FileDescriptor
 
 const file_config_proto_rawDesc = "" +
@@ -1020,24 +1770,98 @@ const file_config_proto_rawDesc = "" +
 	"\n" +
 	"\b_k6_rateB\x0e\n" +
 	"\f_k6_durationB\x0e\n" +
-	"\f_otlp_export\"\xe7\x01\n" +
+	"\f_otlp_export\"\xd2\b\n" +
 	"\fDriverConfig\x126\n" +
 	"\x12driver_plugin_path\x18\x01 \x01(\tB\b\xfaB\x05r\x03\x90\x01\x01R\x10driverPluginPath\x126\n" +
 	"\x12driver_plugin_args\x18\x02 \x03(\tB\b\xfaB\x05\x92\x01\x02\x18\x01R\x10driverPluginArgs\x12\x1a\n" +
 	"\x03url\x18\x03 \x01(\tB\b\xfaB\x05r\x03\x90\x01\x01R\x03url\x12;\n" +
 	"\vdb_specific\x18\x04 \x01(\v2\x15.stroppy.Value.StructH\x00R\n" +
-	"dbSpecific\x88\x01\x01B\x0e\n" +
-	"\f_db_specific\"\xc5\x01\n" +
+	"dbSpecific\x88\x01\x01\x12V\n" +
+	"\x11driver_plugin_env\x18\x05 \x03(\v2*.stroppy.DriverConfig.DriverPluginEnvEntryR\x0fdriverPluginEnv\x122\n" +
+	"\x15driver_plugin_workdir\x18\x06 \x01(\tR\x13driverPluginWorkdir\x12M\n" +
+	"\x14driver_plugin_sha256\x18\a \x01(\tB\x1b\xfaB\x18r\x162\x14^([0-9a-fA-F]{64})?$R\x12driverPluginSha256\x12<\n" +
+	"\fretry_policy\x18\b \x01(\v2\x14.stroppy.RetryPolicyH\x01R\vretryPolicy\x88\x01\x01\x12\x1f\n" +
+	"\vdriver_name\x18\t \x01(\tR\n" +
+	"driverName\x12R\n" +
+	"\x15health_check_interval\x18\n" +
+	" \x01(\v2\x19.google.protobuf.DurationH\x02R\x13healthCheckInterval\x88\x01\x01\x12!\n" +
+	"\fmax_restarts\x18\v \x01(\rR\vmaxRestarts\x128\n" +
+	"\x06remote\x18\f \x01(\v2\x1b.stroppy.RemoteDriverConfigH\x03R\x06remote\x88\x01\x01\x128\n" +
+	"\breattach\x18\r \x01(\v2\x17.stroppy.ReattachConfigH\x04R\breattach\x88\x01\x01\x122\n" +
+	"\x04mock\x18\x0e \x01(\v2\x19.stroppy.MockDriverConfigH\x05R\x04mock\x88\x01\x01\x12d\n" +
+	"\x17driver_plugin_log_level\x18\x0f \x01(\x0e2\x1e.stroppy.LoggerConfig.LogLevelB\b\xfaB\x05\x82\x01\x02\x10\x01H\x06R\x14driverPluginLogLevel\x88\x01\x01\x1aB\n" +
+	"\x14DriverPluginEnvEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\x0e\n" +
+	"\f_db_specificB\x0f\n" +
+	"\r_retry_policyB\x18\n" +
+	"\x16_health_check_intervalB\t\n" +
+	"\a_remoteB\v\n" +
+	"\t_reattachB\a\n" +
+	"\x05_mockB\x1a\n" +
+	"\x18_driver_plugin_log_level\"\xf4\x02\n" +
+	"\x10MockDriverConfig\x12\x1f\n" +
+	"\vrecord_file\x18\x01 \x01(\tR\n" +
+	"recordFile\x12?\n" +
+	"\vmin_latency\x18\x02 \x01(\v2\x19.google.protobuf.DurationH\x00R\n" +
+	"minLatency\x88\x01\x01\x12?\n" +
+	"\vmax_latency\x18\x03 \x01(\v2\x19.google.protobuf.DurationH\x01R\n" +
+	"maxLatency\x88\x01\x01\x12X\n" +
+	"\x14latency_distribution\x18\x04 \x01(\v2 .stroppy.Generation.DistributionH\x02R\x13latencyDistribution\x88\x01\x01\x12*\n" +
+	"\x06errors\x18\x05 \x03(\v2\x12.stroppy.MockErrorR\x06errorsB\x0e\n" +
+	"\f_min_latencyB\x0e\n" +
+	"\f_max_latencyB\x17\n" +
+	"\x15_latency_distribution\"\x9b\x01\n" +
+	"\tMockError\x129\n" +
+	"\vprobability\x18\x01 \x01(\x01B\x17\xfaB\x14\x12\x12\x19\x00\x00\x00\x00\x00\x00\xf0?)\x00\x00\x00\x00\x00\x00\x00\x00R\vprobability\x129\n" +
+	"\x05class\x18\x02 \x01(\x0e2\x19.stroppy.DriverErrorClassB\b\xfaB\x05\x82\x01\x02\x10\x01R\x05class\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"\x86\x01\n" +
+	"\x12RemoteDriverConfig\x12!\n" +
+	"\aaddress\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\aaddress\x12)\n" +
+	"\x03tls\x18\x02 \x01(\v2\x12.stroppy.TlsConfigH\x00R\x03tls\x88\x01\x01\x12\x1a\n" +
+	"\binsecure\x18\x03 \x01(\bR\binsecureB\x06\n" +
+	"\x04_tls\"}\n" +
+	"\tTlsConfig\x12\x17\n" +
+	"\aca_file\x18\x01 \x01(\tR\x06caFile\x12\x1b\n" +
+	"\tcert_file\x18\x02 \x01(\tR\bcertFile\x12\x19\n" +
+	"\bkey_file\x18\x03 \x01(\tR\akeyFile\x12\x1f\n" +
+	"\vserver_name\x18\x04 \x01(\tR\n" +
+	"serverName\"\xa5\x01\n" +
+	"\x0eReattachConfig\x12*\n" +
+	"\anetwork\x18\x01 \x01(\tB\x10\xfaB\rr\vR\x04unixR\x03tcpR\anetwork\x12!\n" +
+	"\aaddress\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\aaddress\x12\x19\n" +
+	"\x03pid\x18\x03 \x01(\x05B\a\xfaB\x04\x1a\x02 \x00R\x03pid\x12)\n" +
+	"\x10protocol_version\x18\x04 \x01(\rR\x0fprotocolVersion\"\xbb\x03\n" +
+	"\vRetryPolicy\x12!\n" +
+	"\fmax_attempts\x18\x01 \x01(\rR\vmaxAttempts\x12G\n" +
+	"\x0finitial_backoff\x18\x02 \x01(\v2\x19.google.protobuf.DurationH\x00R\x0einitialBackoff\x88\x01\x01\x12?\n" +
+	"\vmax_backoff\x18\x03 \x01(\v2\x19.google.protobuf.DurationH\x01R\n" +
+	"maxBackoff\x88\x01\x01\x12B\n" +
+	"\x12backoff_multiplier\x18\x04 \x01(\x01B\x0e\xfaB\v\x12\t)\x00\x00\x00\x00\x00\x00\xf0?H\x02R\x11backoffMultiplier\x88\x01\x01\x12/\n" +
+	"\x06jitter\x18\x05 \x01(\x01B\x17\xfaB\x14\x12\x12\x19\x00\x00\x00\x00\x00\x00\xf0?)\x00\x00\x00\x00\x00\x00\x00\x00R\x06jitter\x12O\n" +
+	"\rretry_classes\x18\x06 \x03(\x0e2\x19.stroppy.DriverErrorClassB\x0f\xfaB\f\x92\x01\t\x18\x01\"\x05\x82\x01\x02\x10\x01R\fretryClassesB\x12\n" +
+	"\x10_initial_backoffB\x0e\n" +
+	"\f_max_backoffB\x15\n" +
+	"\x13_backoff_multiplier\"\xc5\x01\n" +
 	"\rRequestedStep\x12\x1b\n" +
 	"\x04name\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x04name\x12N\n" +
 	"\bexecutor\x18\x02 \x01(\x0e2#.stroppy.RequestedStep.ExecutorTypeB\b\xfaB\x05\x82\x01\x02\x10\x01H\x00R\bexecutor\x88\x01\x01\":\n" +
 	"\fExecutorType\x12\x14\n" +
 	"\x10EXECUTOR_TYPE_GO\x10\x00\x12\x14\n" +
 	"\x10EXECUTOR_TYPE_K6\x10\x01B\v\n" +
-	"\t_executor\"\xca\x02\n" +
+	"\t_executor\"\xa1\x05\n" +
 	"\fLoggerConfig\x12E\n" +
 	"\tlog_level\x18\x01 \x01(\x0e2\x1e.stroppy.LoggerConfig.LogLevelB\b\xfaB\x05\x82\x01\x02\x10\x01R\blogLevel\x12B\n" +
-	"\blog_mode\x18\x02 \x01(\x0e2\x1d.stroppy.LoggerConfig.LogModeB\b\xfaB\x05\x82\x01\x02\x10\x01R\alogMode\"q\n" +
+	"\blog_mode\x18\x02 \x01(\x0e2\x1d.stroppy.LoggerConfig.LogModeB\b\xfaB\x05\x82\x01\x02\x10\x01R\alogMode\x12H\n" +
+	"\n" +
+	"log_format\x18\x03 \x01(\x0e2\x1f.stroppy.LoggerConfig.LogFormatB\b\xfaB\x05\x82\x01\x02\x10\x01R\tlogFormat\x12?\n" +
+	"\bsampling\x18\x04 \x01(\v2\x1e.stroppy.LoggerConfig.SamplingH\x00R\bsampling\x88\x01\x01\x12!\n" +
+	"\foutput_paths\x18\x05 \x03(\tR\voutputPaths\x1aD\n" +
+	"\bSampling\x12\x18\n" +
+	"\ainitial\x18\x01 \x01(\rR\ainitial\x12\x1e\n" +
+	"\n" +
+	"thereafter\x18\x02 \x01(\rR\n" +
+	"thereafter\"q\n" +
 	"\bLogLevel\x12\x13\n" +
 	"\x0fLOG_LEVEL_DEBUG\x10\x00\x12\x12\n" +
 	"\x0eLOG_LEVEL_INFO\x10\x01\x12\x12\n" +
@@ -1046,18 +1870,37 @@ const file_config_proto_rawDesc = "" +
 	"\x0fLOG_LEVEL_FATAL\x10\x04\"<\n" +
 	"\aLogMode\x12\x18\n" +
 	"\x14LOG_MODE_DEVELOPMENT\x10\x00\x12\x17\n" +
-	"\x13LOG_MODE_PRODUCTION\x10\x01\"p\n" +
+	"\x13LOG_MODE_PRODUCTION\x10\x01\"T\n" +
+	"\tLogFormat\x12\x1a\n" +
+	"\x16LOG_FORMAT_UNSPECIFIED\x10\x00\x12\x13\n" +
+	"\x0fLOG_FORMAT_JSON\x10\x01\x12\x16\n" +
+	"\x12LOG_FORMAT_CONSOLE\x10\x02B\v\n" +
+	"\t_sampling\"\xc9\x01\n" +
 	"\vStepContext\x12+\n" +
 	"\x04step\x18\x05 \x01(\v2\x17.stroppy.StepDescriptorR\x04step\x124\n" +
-	"\rglobal_config\x18\x06 \x01(\v2\x0f.stroppy.ConfigR\fglobalConfig\"\xcf\x01\n" +
+	"\rglobal_config\x18\x06 \x01(\v2\x0f.stroppy.ConfigR\fglobalConfig\x12C\n" +
+	"\x0fplugin_settings\x18\a \x01(\v2\x15.stroppy.Value.StructH\x00R\x0epluginSettings\x88\x01\x01B\x12\n" +
+	"\x10_plugin_settings\"\xd2\x04\n" +
 	"\x06Plugin\x122\n" +
 	"\x04type\x18\x01 \x01(\x0e2\x14.stroppy.Plugin.TypeB\b\xfaB\x05\x82\x01\x02\x10\x01R\x04type\x12\x1c\n" +
 	"\x04path\x18\x02 \x01(\tB\b\xfaB\x05r\x03\x90\x01\x01R\x04path\x126\n" +
-	"\bsettings\x18\x03 \x01(\v2\x15.stroppy.Value.StructH\x00R\bsettings\x88\x01\x01\".\n" +
+	"\bsettings\x18\x03 \x01(\v2\x15.stroppy.Value.StructH\x00R\bsettings\x88\x01\x01\x12\x12\n" +
+	"\x04args\x18\x04 \x03(\tR\x04args\x12*\n" +
+	"\x03env\x18\x05 \x03(\v2\x18.stroppy.Plugin.EnvEntryR\x03env\x12\x18\n" +
+	"\aworkdir\x18\x06 \x01(\tR\aworkdir\x123\n" +
+	"\x06sha256\x18\a \x01(\tB\x1b\xfaB\x18r\x162\x14^([0-9a-fA-F]{64})?$R\x06sha256\x12J\n" +
+	"\x11step_gate_timeout\x18\b \x01(\v2\x19.google.protobuf.DurationH\x01R\x0fstepGateTimeout\x88\x01\x01\x12J\n" +
+	"\tlog_level\x18\t \x01(\x0e2\x1e.stroppy.LoggerConfig.LogLevelB\b\xfaB\x05\x82\x01\x02\x10\x01H\x02R\blogLevel\x88\x01\x01\x1a6\n" +
+	"\bEnvEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\".\n" +
 	"\x04Type\x12\x14\n" +
 	"\x10TYPE_UNSPECIFIED\x10\x00\x12\x10\n" +
 	"\fTYPE_SIDECAR\x10\x01B\v\n" +
-	"\t_settings\"\xf8\x03\n" +
+	"\t_settingsB\x14\n" +
+	"\x12_step_gate_timeoutB\f\n" +
+	"\n" +
+	"_log_level\"\xf8\x03\n" +
 	"\tRunConfig\x12\x15\n" +
 	"\x06run_id\x18\x01 \x01(\tR\x05runId\x12\x1b\n" +
 	"\x04seed\x18\x02 \x01(\x04B\a\xfaB\x042\x02(\x00R\x04seed\x127\n" +
@@ -1090,55 +1933,89 @@ func file_config_proto_rawDescGZIP() []byte {
 	return file_config_proto_rawDescData
 }
 
-var file_config_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_config_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_config_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_config_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
 var file_config_proto_goTypes = []any{
 	(RequestedStep_ExecutorType)(0), // 0: stroppy.RequestedStep.ExecutorType
 	(LoggerConfig_LogLevel)(0),      // 1: stroppy.LoggerConfig.LogLevel
 	(LoggerConfig_LogMode)(0),       // 2: stroppy.LoggerConfig.LogMode
-	(Plugin_Type)(0),                // 3: stroppy.Plugin.Type
-	(*OtlpExport)(nil),              // 4: stroppy.OtlpExport
-	(*GoExecutor)(nil),              // 5: stroppy.GoExecutor
-	(*K6Executor)(nil),              // 6: stroppy.K6Executor
-	(*DriverConfig)(nil),            // 7: stroppy.DriverConfig
-	(*RequestedStep)(nil),           // 8: stroppy.RequestedStep
-	(*LoggerConfig)(nil),            // 9: stroppy.LoggerConfig
-	(*StepContext)(nil),             // 10: stroppy.StepContext
-	(*Plugin)(nil),                  // 11: stroppy.Plugin
-	(*RunConfig)(nil),               // 12: stroppy.RunConfig
-	(*Config)(nil),                  // 13: stroppy.Config
-	nil,                             // 14: stroppy.RunConfig.MetadataEntry
-	(*durationpb.Duration)(nil),     // 15: google.protobuf.Duration
-	(*Value_Struct)(nil),            // 16: stroppy.Value.Struct
-	(*StepDescriptor)(nil),          // 17: stroppy.StepDescriptor
-	(*BenchmarkDescriptor)(nil),     // 18: stroppy.BenchmarkDescriptor
+	(LoggerConfig_LogFormat)(0),     // 3: stroppy.LoggerConfig.LogFormat
+	(Plugin_Type)(0),                // 4: stroppy.Plugin.Type
+	(*OtlpExport)(nil),              // 5: stroppy.OtlpExport
+	(*GoExecutor)(nil),              // 6: stroppy.GoExecutor
+	(*K6Executor)(nil),              // 7: stroppy.K6Executor
+	(*DriverConfig)(nil),            // 8: stroppy.DriverConfig
+	(*MockDriverConfig)(nil),        // 9: stroppy.MockDriverConfig
+	(*MockError)(nil),               // 10: stroppy.MockError
+	(*RemoteDriverConfig)(nil),      // 11: stroppy.RemoteDriverConfig
+	(*TlsConfig)(nil),               // 12: stroppy.TlsConfig
+	(*ReattachConfig)(nil),          // 13: stroppy.ReattachConfig
+	(*RetryPolicy)(nil),             // 14: stroppy.RetryPolicy
+	(*RequestedStep)(nil),           // 15: stroppy.RequestedStep
+	(*LoggerConfig)(nil),            // 16: stroppy.LoggerConfig
+	(*StepContext)(nil),             // 17: stroppy.StepContext
+	(*Plugin)(nil),                  // 18: stroppy.Plugin
+	(*RunConfig)(nil),               // 19: stroppy.RunConfig
+	(*Config)(nil),                  // 20: stroppy.Config
+	nil,                             // 21: stroppy.DriverConfig.DriverPluginEnvEntry
+	(*LoggerConfig_Sampling)(nil),   // 22: stroppy.LoggerConfig.Sampling
+	nil,                             // 23: stroppy.Plugin.EnvEntry
+	nil,                             // 24: stroppy.RunConfig.MetadataEntry
+	(*durationpb.Duration)(nil),     // 25: google.protobuf.Duration
+	(*Value_Struct)(nil),            // 26: stroppy.Value.Struct
+	(*Generation_Distribution)(nil), // 27: stroppy.Generation.Distribution
+	(DriverErrorClass)(0),           // 28: stroppy.DriverErrorClass
+	(*StepDescriptor)(nil),          // 29: stroppy.StepDescriptor
+	(*BenchmarkDescriptor)(nil),     // 30: stroppy.BenchmarkDescriptor
 }
 var file_config_proto_depIdxs = []int32{
-	15, // 0: stroppy.K6Executor.k6_setup_timeout:type_name -> google.protobuf.Duration
-	15, // 1: stroppy.K6Executor.k6_duration:type_name -> google.protobuf.Duration
-	4,  // 2: stroppy.K6Executor.otlp_export:type_name -> stroppy.OtlpExport
-	16, // 3: stroppy.DriverConfig.db_specific:type_name -> stroppy.Value.Struct
-	0,  // 4: stroppy.RequestedStep.executor:type_name -> stroppy.RequestedStep.ExecutorType
-	1,  // 5: stroppy.LoggerConfig.log_level:type_name -> stroppy.LoggerConfig.LogLevel
-	2,  // 6: stroppy.LoggerConfig.log_mode:type_name -> stroppy.LoggerConfig.LogMode
-	17, // 7: stroppy.StepContext.step:type_name -> stroppy.StepDescriptor
-	13, // 8: stroppy.StepContext.global_config:type_name -> stroppy.Config
-	3,  // 9: stroppy.Plugin.type:type_name -> stroppy.Plugin.Type
-	16, // 10: stroppy.Plugin.settings:type_name -> stroppy.Value.Struct
-	7,  // 11: stroppy.RunConfig.driver:type_name -> stroppy.DriverConfig
-	5,  // 12: stroppy.RunConfig.go_executor:type_name -> stroppy.GoExecutor
-	6,  // 13: stroppy.RunConfig.k6_executor:type_name -> stroppy.K6Executor
-	8,  // 14: stroppy.RunConfig.steps:type_name -> stroppy.RequestedStep
-	9,  // 15: stroppy.RunConfig.logger:type_name -> stroppy.LoggerConfig
-	14, // 16: stroppy.RunConfig.metadata:type_name -> stroppy.RunConfig.MetadataEntry
-	11, // 17: stroppy.RunConfig.plugins:type_name -> stroppy.Plugin
-	12, // 18: stroppy.Config.run:type_name -> stroppy.RunConfig
-	18, // 19: stroppy.Config.benchmark:type_name -> stroppy.BenchmarkDescriptor
-	20, // [20:20] is the sub-list for method output_type
-	20, // [20:20] is the sub-list for method input_type
-	20, // [20:20] is the sub-list for extension type_name
-	20, // [20:20] is the sub-list for extension extendee
-	0,  // [0:20] is the sub-list for field type_name
+	25, // 0: stroppy.K6Executor.k6_setup_timeout:type_name -> google.protobuf.Duration
+	25, // 1: stroppy.K6Executor.k6_duration:type_name -> google.protobuf.Duration
+	5,  // 2: stroppy.K6Executor.otlp_export:type_name -> stroppy.OtlpExport
+	26, // 3: stroppy.DriverConfig.db_specific:type_name -> stroppy.Value.Struct
+	21, // 4: stroppy.DriverConfig.driver_plugin_env:type_name -> stroppy.DriverConfig.DriverPluginEnvEntry
+	14, // 5: stroppy.DriverConfig.retry_policy:type_name -> stroppy.RetryPolicy
+	25, // 6: stroppy.DriverConfig.health_check_interval:type_name -> google.protobuf.Duration
+	11, // 7: stroppy.DriverConfig.remote:type_name -> stroppy.RemoteDriverConfig
+	13, // 8: stroppy.DriverConfig.reattach:type_name -> stroppy.ReattachConfig
+	9,  // 9: stroppy.DriverConfig.mock:type_name -> stroppy.MockDriverConfig
+	1,  // 10: stroppy.DriverConfig.driver_plugin_log_level:type_name -> stroppy.LoggerConfig.LogLevel
+	25, // 11: stroppy.MockDriverConfig.min_latency:type_name -> google.protobuf.Duration
+	25, // 12: stroppy.MockDriverConfig.max_latency:type_name -> google.protobuf.Duration
+	27, // 13: stroppy.MockDriverConfig.latency_distribution:type_name -> stroppy.Generation.Distribution
+	10, // 14: stroppy.MockDriverConfig.errors:type_name -> stroppy.MockError
+	28, // 15: stroppy.MockError.class:type_name -> stroppy.DriverErrorClass
+	12, // 16: stroppy.RemoteDriverConfig.tls:type_name -> stroppy.TlsConfig
+	25, // 17: stroppy.RetryPolicy.initial_backoff:type_name -> google.protobuf.Duration
+	25, // 18: stroppy.RetryPolicy.max_backoff:type_name -> google.protobuf.Duration
+	28, // 19: stroppy.RetryPolicy.retry_classes:type_name -> stroppy.DriverErrorClass
+	0,  // 20: stroppy.RequestedStep.executor:type_name -> stroppy.RequestedStep.ExecutorType
+	1,  // 21: stroppy.LoggerConfig.log_level:type_name -> stroppy.LoggerConfig.LogLevel
+	2,  // 22: stroppy.LoggerConfig.log_mode:type_name -> stroppy.LoggerConfig.LogMode
+	3,  // 23: stroppy.LoggerConfig.log_format:type_name -> stroppy.LoggerConfig.LogFormat
+	22, // 24: stroppy.LoggerConfig.sampling:type_name -> stroppy.LoggerConfig.Sampling
+	29, // 25: stroppy.StepContext.step:type_name -> stroppy.StepDescriptor
+	20, // 26: stroppy.StepContext.global_config:type_name -> stroppy.Config
+	26, // 27: stroppy.StepContext.plugin_settings:type_name -> stroppy.Value.Struct
+	4,  // 28: stroppy.Plugin.type:type_name -> stroppy.Plugin.Type
+	26, // 29: stroppy.Plugin.settings:type_name -> stroppy.Value.Struct
+	23, // 30: stroppy.Plugin.env:type_name -> stroppy.Plugin.EnvEntry
+	25, // 31: stroppy.Plugin.step_gate_timeout:type_name -> google.protobuf.Duration
+	1,  // 32: stroppy.Plugin.log_level:type_name -> stroppy.LoggerConfig.LogLevel
+	8,  // 33: stroppy.RunConfig.driver:type_name -> stroppy.DriverConfig
+	6,  // 34: stroppy.RunConfig.go_executor:type_name -> stroppy.GoExecutor
+	7,  // 35: stroppy.RunConfig.k6_executor:type_name -> stroppy.K6Executor
+	15, // 36: stroppy.RunConfig.steps:type_name -> stroppy.RequestedStep
+	16, // 37: stroppy.RunConfig.logger:type_name -> stroppy.LoggerConfig
+	24, // 38: stroppy.RunConfig.metadata:type_name -> stroppy.RunConfig.MetadataEntry
+	18, // 39: stroppy.RunConfig.plugins:type_name -> stroppy.Plugin
+	19, // 40: stroppy.Config.run:type_name -> stroppy.RunConfig
+	30, // 41: stroppy.Config.benchmark:type_name -> stroppy.BenchmarkDescriptor
+	42, // [42:42] is the sub-list for method output_type
+	42, // [42:42] is the sub-list for method input_type
+	42, // [42:42] is the sub-list for extension type_name
+	42, // [42:42] is the sub-list for extension extendee
+	0,  // [0:42] is the sub-list for field type_name
 }
 
 func init() { file_config_proto_init() }
@@ -1153,14 +2030,19 @@ func file_config_proto_init() {
 	file_config_proto_msgTypes[2].OneofWrappers = []any{}
 	file_config_proto_msgTypes[3].OneofWrappers = []any{}
 	file_config_proto_msgTypes[4].OneofWrappers = []any{}
-	file_config_proto_msgTypes[7].OneofWrappers = []any{}
+	file_config_proto_msgTypes[6].OneofWrappers = []any{}
+	file_config_proto_msgTypes[9].OneofWrappers = []any{}
+	file_config_proto_msgTypes[10].OneofWrappers = []any{}
+	file_config_proto_msgTypes[11].OneofWrappers = []any{}
+	file_config_proto_msgTypes[12].OneofWrappers = []any{}
+	file_config_proto_msgTypes[13].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_config_proto_rawDesc), len(file_config_proto_rawDesc)),
-			NumEnums:      4,
-			NumMessages:   11,
+			NumEnums:      5,
+			NumMessages:   20,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
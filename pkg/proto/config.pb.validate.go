@@ -628,31 +628,1194 @@ func (m *DriverConfig) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for DriverPluginEnv
+
+	// no validation rules for DriverPluginWorkdir
+
+	if !_DriverConfig_DriverPluginSha256_Pattern.MatchString(m.GetDriverPluginSha256()) {
+		err := DriverConfigValidationError{
+			field:  "DriverPluginSha256",
+			reason: "value does not match regex pattern \"^([0-9a-fA-F]{64})?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for DriverName
+
+	// no validation rules for MaxRestarts
+
 	if m.DbSpecific != nil {
 
 		if all {
-			switch v := interface{}(m.GetDbSpecific()).(type) {
+			switch v := interface{}(m.GetDbSpecific()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DriverConfigValidationError{
+						field:  "DbSpecific",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DriverConfigValidationError{
+						field:  "DbSpecific",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetDbSpecific()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DriverConfigValidationError{
+					field:  "DbSpecific",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.RetryPolicy != nil {
+
+		if all {
+			switch v := interface{}(m.GetRetryPolicy()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DriverConfigValidationError{
+						field:  "RetryPolicy",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DriverConfigValidationError{
+						field:  "RetryPolicy",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetRetryPolicy()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DriverConfigValidationError{
+					field:  "RetryPolicy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.HealthCheckInterval != nil {
+
+		if all {
+			switch v := interface{}(m.GetHealthCheckInterval()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DriverConfigValidationError{
+						field:  "HealthCheckInterval",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DriverConfigValidationError{
+						field:  "HealthCheckInterval",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetHealthCheckInterval()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DriverConfigValidationError{
+					field:  "HealthCheckInterval",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.Remote != nil {
+
+		if all {
+			switch v := interface{}(m.GetRemote()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DriverConfigValidationError{
+						field:  "Remote",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DriverConfigValidationError{
+						field:  "Remote",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetRemote()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DriverConfigValidationError{
+					field:  "Remote",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.Reattach != nil {
+
+		if all {
+			switch v := interface{}(m.GetReattach()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DriverConfigValidationError{
+						field:  "Reattach",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DriverConfigValidationError{
+						field:  "Reattach",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetReattach()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DriverConfigValidationError{
+					field:  "Reattach",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.Mock != nil {
+
+		if all {
+			switch v := interface{}(m.GetMock()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DriverConfigValidationError{
+						field:  "Mock",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DriverConfigValidationError{
+						field:  "Mock",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetMock()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DriverConfigValidationError{
+					field:  "Mock",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.DriverPluginLogLevel != nil {
+
+		if _, ok := LoggerConfig_LogLevel_name[int32(m.GetDriverPluginLogLevel())]; !ok {
+			err := DriverConfigValidationError{
+				field:  "DriverPluginLogLevel",
+				reason: "value must be one of the defined enum values",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return DriverConfigMultiError(errors)
+	}
+
+	return nil
+}
+
+// DriverConfigMultiError is an error wrapping multiple validation errors
+// returned by DriverConfig.ValidateAll() if the designated constraints aren't met.
+type DriverConfigMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DriverConfigMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DriverConfigMultiError) AllErrors() []error { return m }
+
+// DriverConfigValidationError is the validation error returned by
+// DriverConfig.Validate if the designated constraints aren't met.
+type DriverConfigValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DriverConfigValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DriverConfigValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DriverConfigValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DriverConfigValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DriverConfigValidationError) ErrorName() string { return "DriverConfigValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DriverConfigValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDriverConfig.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DriverConfigValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DriverConfigValidationError{}
+
+var _DriverConfig_DriverPluginSha256_Pattern = regexp.MustCompile("^([0-9a-fA-F]{64})?$")
+
+// Validate checks the field values on MockDriverConfig with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MockDriverConfig) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MockDriverConfig with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MockDriverConfigMultiError, or nil if none found.
+func (m *MockDriverConfig) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MockDriverConfig) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RecordFile
+
+	for idx, item := range m.GetErrors() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MockDriverConfigValidationError{
+						field:  fmt.Sprintf("Errors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MockDriverConfigValidationError{
+						field:  fmt.Sprintf("Errors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MockDriverConfigValidationError{
+					field:  fmt.Sprintf("Errors[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.MinLatency != nil {
+
+		if all {
+			switch v := interface{}(m.GetMinLatency()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MockDriverConfigValidationError{
+						field:  "MinLatency",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MockDriverConfigValidationError{
+						field:  "MinLatency",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetMinLatency()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MockDriverConfigValidationError{
+					field:  "MinLatency",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.MaxLatency != nil {
+
+		if all {
+			switch v := interface{}(m.GetMaxLatency()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MockDriverConfigValidationError{
+						field:  "MaxLatency",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MockDriverConfigValidationError{
+						field:  "MaxLatency",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetMaxLatency()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MockDriverConfigValidationError{
+					field:  "MaxLatency",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.LatencyDistribution != nil {
+
+		if all {
+			switch v := interface{}(m.GetLatencyDistribution()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MockDriverConfigValidationError{
+						field:  "LatencyDistribution",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MockDriverConfigValidationError{
+						field:  "LatencyDistribution",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetLatencyDistribution()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MockDriverConfigValidationError{
+					field:  "LatencyDistribution",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return MockDriverConfigMultiError(errors)
+	}
+
+	return nil
+}
+
+// MockDriverConfigMultiError is an error wrapping multiple validation errors
+// returned by MockDriverConfig.ValidateAll() if the designated constraints
+// aren't met.
+type MockDriverConfigMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MockDriverConfigMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MockDriverConfigMultiError) AllErrors() []error { return m }
+
+// MockDriverConfigValidationError is the validation error returned by
+// MockDriverConfig.Validate if the designated constraints aren't met.
+type MockDriverConfigValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MockDriverConfigValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MockDriverConfigValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MockDriverConfigValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MockDriverConfigValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MockDriverConfigValidationError) ErrorName() string { return "MockDriverConfigValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MockDriverConfigValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMockDriverConfig.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MockDriverConfigValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MockDriverConfigValidationError{}
+
+// Validate checks the field values on MockError with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MockError) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MockError with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MockErrorMultiError, or nil
+// if none found.
+func (m *MockError) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MockError) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if val := m.GetProbability(); val < 0 || val > 1 {
+		err := MockErrorValidationError{
+			field:  "Probability",
+			reason: "value must be inside range [0, 1]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := DriverErrorClass_name[int32(m.GetClass())]; !ok {
+		err := MockErrorValidationError{
+			field:  "Class",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return MockErrorMultiError(errors)
+	}
+
+	return nil
+}
+
+// MockErrorMultiError is an error wrapping multiple validation errors returned
+// by MockError.ValidateAll() if the designated constraints aren't met.
+type MockErrorMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MockErrorMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MockErrorMultiError) AllErrors() []error { return m }
+
+// MockErrorValidationError is the validation error returned by
+// MockError.Validate if the designated constraints aren't met.
+type MockErrorValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MockErrorValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MockErrorValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MockErrorValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MockErrorValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MockErrorValidationError) ErrorName() string { return "MockErrorValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MockErrorValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMockError.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MockErrorValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MockErrorValidationError{}
+
+// Validate checks the field values on RemoteDriverConfig with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoteDriverConfig) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoteDriverConfig with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RemoteDriverConfigMultiError, or nil if none found.
+func (m *RemoteDriverConfig) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoteDriverConfig) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetAddress()) < 1 {
+		err := RemoteDriverConfigValidationError{
+			field:  "Address",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Insecure
+
+	if m.Tls != nil {
+
+		if all {
+			switch v := interface{}(m.GetTls()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RemoteDriverConfigValidationError{
+						field:  "Tls",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RemoteDriverConfigValidationError{
+						field:  "Tls",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetTls()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RemoteDriverConfigValidationError{
+					field:  "Tls",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return RemoteDriverConfigMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoteDriverConfigMultiError is an error wrapping multiple validation errors
+// returned by RemoteDriverConfig.ValidateAll() if the designated constraints
+// aren't met.
+type RemoteDriverConfigMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoteDriverConfigMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoteDriverConfigMultiError) AllErrors() []error { return m }
+
+// RemoteDriverConfigValidationError is the validation error returned by
+// RemoteDriverConfig.Validate if the designated constraints aren't met.
+type RemoteDriverConfigValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoteDriverConfigValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoteDriverConfigValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoteDriverConfigValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoteDriverConfigValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoteDriverConfigValidationError) ErrorName() string {
+	return "RemoteDriverConfigValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoteDriverConfigValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoteDriverConfig.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoteDriverConfigValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoteDriverConfigValidationError{}
+
+// Validate checks the field values on TlsConfig with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TlsConfig) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TlsConfig with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TlsConfigMultiError, or nil
+// if none found.
+func (m *TlsConfig) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TlsConfig) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CaFile
+
+	// no validation rules for CertFile
+
+	// no validation rules for KeyFile
+
+	// no validation rules for ServerName
+
+	if len(errors) > 0 {
+		return TlsConfigMultiError(errors)
+	}
+
+	return nil
+}
+
+// TlsConfigMultiError is an error wrapping multiple validation errors returned
+// by TlsConfig.ValidateAll() if the designated constraints aren't met.
+type TlsConfigMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TlsConfigMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TlsConfigMultiError) AllErrors() []error { return m }
+
+// TlsConfigValidationError is the validation error returned by
+// TlsConfig.Validate if the designated constraints aren't met.
+type TlsConfigValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TlsConfigValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TlsConfigValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TlsConfigValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TlsConfigValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TlsConfigValidationError) ErrorName() string { return "TlsConfigValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TlsConfigValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTlsConfig.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TlsConfigValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TlsConfigValidationError{}
+
+// Validate checks the field values on ReattachConfig with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ReattachConfig) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReattachConfig with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ReattachConfigMultiError,
+// or nil if none found.
+func (m *ReattachConfig) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReattachConfig) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _ReattachConfig_Network_InLookup[m.GetNetwork()]; !ok {
+		err := ReattachConfigValidationError{
+			field:  "Network",
+			reason: "value must be in list [unix tcp]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetAddress()) < 1 {
+		err := ReattachConfigValidationError{
+			field:  "Address",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetPid() <= 0 {
+		err := ReattachConfigValidationError{
+			field:  "Pid",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for ProtocolVersion
+
+	if len(errors) > 0 {
+		return ReattachConfigMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReattachConfigMultiError is an error wrapping multiple validation errors
+// returned by ReattachConfig.ValidateAll() if the designated constraints
+// aren't met.
+type ReattachConfigMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReattachConfigMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReattachConfigMultiError) AllErrors() []error { return m }
+
+// ReattachConfigValidationError is the validation error returned by
+// ReattachConfig.Validate if the designated constraints aren't met.
+type ReattachConfigValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReattachConfigValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReattachConfigValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReattachConfigValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReattachConfigValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReattachConfigValidationError) ErrorName() string { return "ReattachConfigValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReattachConfigValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReattachConfig.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReattachConfigValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReattachConfigValidationError{}
+
+var _ReattachConfig_Network_InLookup = map[string]struct{}{
+	"unix": {},
+	"tcp":  {},
+}
+
+// Validate checks the field values on RetryPolicy with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RetryPolicy) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RetryPolicy with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RetryPolicyMultiError, or
+// nil if none found.
+func (m *RetryPolicy) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RetryPolicy) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MaxAttempts
+
+	if val := m.GetJitter(); val < 0 || val > 1 {
+		err := RetryPolicyValidationError{
+			field:  "Jitter",
+			reason: "value must be inside range [0, 1]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_RetryPolicy_RetryClasses_Unique := make(map[DriverErrorClass]struct{}, len(m.GetRetryClasses()))
+
+	for idx, item := range m.GetRetryClasses() {
+		_, _ = idx, item
+
+		if _, exists := _RetryPolicy_RetryClasses_Unique[item]; exists {
+			err := RetryPolicyValidationError{
+				field:  fmt.Sprintf("RetryClasses[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_RetryPolicy_RetryClasses_Unique[item] = struct{}{}
+		}
+
+		if _, ok := DriverErrorClass_name[int32(item)]; !ok {
+			err := RetryPolicyValidationError{
+				field:  fmt.Sprintf("RetryClasses[%v]", idx),
+				reason: "value must be one of the defined enum values",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.InitialBackoff != nil {
+
+		if all {
+			switch v := interface{}(m.GetInitialBackoff()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, DriverConfigValidationError{
-						field:  "DbSpecific",
+					errors = append(errors, RetryPolicyValidationError{
+						field:  "InitialBackoff",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, DriverConfigValidationError{
-						field:  "DbSpecific",
+					errors = append(errors, RetryPolicyValidationError{
+						field:  "InitialBackoff",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetDbSpecific()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetInitialBackoff()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return DriverConfigValidationError{
-					field:  "DbSpecific",
+				return RetryPolicyValidationError{
+					field:  "InitialBackoff",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.MaxBackoff != nil {
+
+		if all {
+			switch v := interface{}(m.GetMaxBackoff()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RetryPolicyValidationError{
+						field:  "MaxBackoff",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RetryPolicyValidationError{
+						field:  "MaxBackoff",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetMaxBackoff()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RetryPolicyValidationError{
+					field:  "MaxBackoff",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -661,19 +1824,34 @@ func (m *DriverConfig) validate(all bool) error {
 
 	}
 
+	if m.BackoffMultiplier != nil {
+
+		if m.GetBackoffMultiplier() < 1 {
+			err := RetryPolicyValidationError{
+				field:  "BackoffMultiplier",
+				reason: "value must be greater than or equal to 1",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
 	if len(errors) > 0 {
-		return DriverConfigMultiError(errors)
+		return RetryPolicyMultiError(errors)
 	}
 
 	return nil
 }
 
-// DriverConfigMultiError is an error wrapping multiple validation errors
-// returned by DriverConfig.ValidateAll() if the designated constraints aren't met.
-type DriverConfigMultiError []error
+// RetryPolicyMultiError is an error wrapping multiple validation errors
+// returned by RetryPolicy.ValidateAll() if the designated constraints aren't met.
+type RetryPolicyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DriverConfigMultiError) Error() string {
+func (m RetryPolicyMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -682,11 +1860,11 @@ func (m DriverConfigMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DriverConfigMultiError) AllErrors() []error { return m }
+func (m RetryPolicyMultiError) AllErrors() []error { return m }
 
-// DriverConfigValidationError is the validation error returned by
-// DriverConfig.Validate if the designated constraints aren't met.
-type DriverConfigValidationError struct {
+// RetryPolicyValidationError is the validation error returned by
+// RetryPolicy.Validate if the designated constraints aren't met.
+type RetryPolicyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -694,22 +1872,22 @@ type DriverConfigValidationError struct {
 }
 
 // Field function returns field value.
-func (e DriverConfigValidationError) Field() string { return e.field }
+func (e RetryPolicyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DriverConfigValidationError) Reason() string { return e.reason }
+func (e RetryPolicyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DriverConfigValidationError) Cause() error { return e.cause }
+func (e RetryPolicyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DriverConfigValidationError) Key() bool { return e.key }
+func (e RetryPolicyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DriverConfigValidationError) ErrorName() string { return "DriverConfigValidationError" }
+func (e RetryPolicyValidationError) ErrorName() string { return "RetryPolicyValidationError" }
 
 // Error satisfies the builtin error interface
-func (e DriverConfigValidationError) Error() string {
+func (e RetryPolicyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -721,14 +1899,14 @@ func (e DriverConfigValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDriverConfig.%s: %s%s",
+		"invalid %sRetryPolicy.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DriverConfigValidationError{}
+var _ error = RetryPolicyValidationError{}
 
 var _ interface {
 	Field() string
@@ -736,7 +1914,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DriverConfigValidationError{}
+} = RetryPolicyValidationError{}
 
 // Validate checks the field values on RequestedStep with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
@@ -908,6 +2086,50 @@ func (m *LoggerConfig) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if _, ok := LoggerConfig_LogFormat_name[int32(m.GetLogFormat())]; !ok {
+		err := LoggerConfigValidationError{
+			field:  "LogFormat",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.Sampling != nil {
+
+		if all {
+			switch v := interface{}(m.GetSampling()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, LoggerConfigValidationError{
+						field:  "Sampling",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, LoggerConfigValidationError{
+						field:  "Sampling",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetSampling()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return LoggerConfigValidationError{
+					field:  "Sampling",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return LoggerConfigMultiError(errors)
 	}
@@ -1065,6 +2287,39 @@ func (m *StepContext) validate(all bool) error {
 		}
 	}
 
+	if m.PluginSettings != nil {
+
+		if all {
+			switch v := interface{}(m.GetPluginSettings()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, StepContextValidationError{
+						field:  "PluginSettings",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, StepContextValidationError{
+						field:  "PluginSettings",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetPluginSettings()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StepContextValidationError{
+					field:  "PluginSettings",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return StepContextMultiError(errors)
 	}
@@ -1186,6 +2441,21 @@ func (m *Plugin) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for Env
+
+	// no validation rules for Workdir
+
+	if !_Plugin_Sha256_Pattern.MatchString(m.GetSha256()) {
+		err := PluginValidationError{
+			field:  "Sha256",
+			reason: "value does not match regex pattern \"^([0-9a-fA-F]{64})?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if m.Settings != nil {
 
 		if all {
@@ -1219,6 +2489,54 @@ func (m *Plugin) validate(all bool) error {
 
 	}
 
+	if m.StepGateTimeout != nil {
+
+		if all {
+			switch v := interface{}(m.GetStepGateTimeout()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PluginValidationError{
+						field:  "StepGateTimeout",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PluginValidationError{
+						field:  "StepGateTimeout",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetStepGateTimeout()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PluginValidationError{
+					field:  "StepGateTimeout",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.LogLevel != nil {
+
+		if _, ok := LoggerConfig_LogLevel_name[int32(m.GetLogLevel())]; !ok {
+			err := PluginValidationError{
+				field:  "LogLevel",
+				reason: "value must be one of the defined enum values",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return PluginMultiError(errors)
 	}
@@ -1296,6 +2614,8 @@ var _ interface {
 	ErrorName() string
 } = PluginValidationError{}
 
+var _Plugin_Sha256_Pattern = regexp.MustCompile("^([0-9a-fA-F]{64})?$")
+
 // Validate checks the field values on RunConfig with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -1806,3 +3126,109 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = ConfigValidationError{}
+
+// Validate checks the field values on LoggerConfig_Sampling with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *LoggerConfig_Sampling) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LoggerConfig_Sampling with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// LoggerConfig_SamplingMultiError, or nil if none found.
+func (m *LoggerConfig_Sampling) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LoggerConfig_Sampling) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Initial
+
+	// no validation rules for Thereafter
+
+	if len(errors) > 0 {
+		return LoggerConfig_SamplingMultiError(errors)
+	}
+
+	return nil
+}
+
+// LoggerConfig_SamplingMultiError is an error wrapping multiple validation
+// errors returned by LoggerConfig_Sampling.ValidateAll() if the designated
+// constraints aren't met.
+type LoggerConfig_SamplingMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LoggerConfig_SamplingMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LoggerConfig_SamplingMultiError) AllErrors() []error { return m }
+
+// LoggerConfig_SamplingValidationError is the validation error returned by
+// LoggerConfig_Sampling.Validate if the designated constraints aren't met.
+type LoggerConfig_SamplingValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LoggerConfig_SamplingValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LoggerConfig_SamplingValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LoggerConfig_SamplingValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LoggerConfig_SamplingValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LoggerConfig_SamplingValidationError) ErrorName() string {
+	return "LoggerConfig_SamplingValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e LoggerConfig_SamplingValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLoggerConfig_Sampling.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LoggerConfig_SamplingValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LoggerConfig_SamplingValidationError{}
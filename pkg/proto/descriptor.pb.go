@@ -79,6 +79,60 @@ func (TxIsolationLevel) EnumDescriptor() ([]byte, []int) {
 	return file_descriptor_proto_rawDescGZIP(), []int{0}
 }
 
+// *
+// InsertMethod defines how rows of InsertDescriptor are delivered to the database.
+type InsertMethod int32
+
+const (
+	// * Multi-row INSERT ... VALUES queries
+	InsertMethod_INSERT_METHOD_PLAIN_QUERY InsertMethod = 0
+	// * COPY-style streaming of row batches
+	InsertMethod_INSERT_METHOD_COPY_FROM InsertMethod = 1
+	// * Driver-defined bulk API
+	InsertMethod_INSERT_METHOD_BULK InsertMethod = 2
+)
+
+// Enum value maps for InsertMethod.
+var (
+	InsertMethod_name = map[int32]string{
+		0: "INSERT_METHOD_PLAIN_QUERY",
+		1: "INSERT_METHOD_COPY_FROM",
+		2: "INSERT_METHOD_BULK",
+	}
+	InsertMethod_value = map[string]int32{
+		"INSERT_METHOD_PLAIN_QUERY": 0,
+		"INSERT_METHOD_COPY_FROM":   1,
+		"INSERT_METHOD_BULK":        2,
+	}
+)
+
+func (x InsertMethod) Enum() *InsertMethod {
+	p := new(InsertMethod)
+	*p = x
+	return p
+}
+
+func (x InsertMethod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (InsertMethod) Descriptor() protoreflect.EnumDescriptor {
+	return file_descriptor_proto_enumTypes[1].Descriptor()
+}
+
+func (InsertMethod) Type() protoreflect.EnumType {
+	return &file_descriptor_proto_enumTypes[1]
+}
+
+func (x InsertMethod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use InsertMethod.Descriptor instead.
+func (InsertMethod) EnumDescriptor() ([]byte, []int) {
+	return file_descriptor_proto_rawDescGZIP(), []int{1}
+}
+
 // *
 // IndexDescriptor defines the structure of a database index.
 type IndexDescriptor struct {
@@ -577,9 +631,177 @@ func (x *TransactionDescriptor) GetDbSpecific() *Value_Struct {
 	return nil
 }
 
+// *
+// InsertColumnDescriptor defines how values of a single column are generated during table population.
+type InsertColumnDescriptor struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// * Name of the column
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// * Rule for generating column values
+	GenerationRule *Generation_Rule `protobuf:"bytes,2,opt,name=generation_rule,json=generationRule,proto3" json:"generation_rule,omitempty"`
+	// * Database-specific column properties
+	DbSpecific    *Value_Struct `protobuf:"bytes,3,opt,name=db_specific,json=dbSpecific,proto3" json:"db_specific,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InsertColumnDescriptor) Reset() {
+	*x = InsertColumnDescriptor{}
+	mi := &file_descriptor_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InsertColumnDescriptor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InsertColumnDescriptor) ProtoMessage() {}
+
+func (x *InsertColumnDescriptor) ProtoReflect() protoreflect.Message {
+	mi := &file_descriptor_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InsertColumnDescriptor.ProtoReflect.Descriptor instead.
+func (*InsertColumnDescriptor) Descriptor() ([]byte, []int) {
+	return file_descriptor_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *InsertColumnDescriptor) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InsertColumnDescriptor) GetGenerationRule() *Generation_Rule {
+	if x != nil {
+		return x.GenerationRule
+	}
+	return nil
+}
+
+func (x *InsertColumnDescriptor) GetDbSpecific() *Value_Struct {
+	if x != nil {
+		return x.DbSpecific
+	}
+	return nil
+}
+
+// *
+// InsertDescriptor defines a table population operation.
+// It fills the table with count rows generated from per-column rules, sending them in batches.
+type InsertDescriptor struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// * Name of the insert operation
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// * Name of the table to populate
+	TableName string `protobuf:"bytes,2,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
+	// * Columns to fill with their generation rules
+	Columns []*InsertColumnDescriptor `protobuf:"bytes,3,rep,name=columns,proto3" json:"columns,omitempty"`
+	// * Number of rows to insert
+	Count uint64 `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	// * Number of rows sent to the database at once (0 = default batch size)
+	BatchSize uint64 `protobuf:"varint,5,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	// * Method used to deliver rows to the database
+	Method InsertMethod `protobuf:"varint,6,opt,name=method,proto3,enum=stroppy.InsertMethod" json:"method,omitempty"`
+	// * Database-specific insert properties
+	DbSpecific    *Value_Struct `protobuf:"bytes,7,opt,name=db_specific,json=dbSpecific,proto3" json:"db_specific,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InsertDescriptor) Reset() {
+	*x = InsertDescriptor{}
+	mi := &file_descriptor_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InsertDescriptor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InsertDescriptor) ProtoMessage() {}
+
+func (x *InsertDescriptor) ProtoReflect() protoreflect.Message {
+	mi := &file_descriptor_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InsertDescriptor.ProtoReflect.Descriptor instead.
+func (*InsertDescriptor) Descriptor() ([]byte, []int) {
+	return file_descriptor_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *InsertDescriptor) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InsertDescriptor) GetTableName() string {
+	if x != nil {
+		return x.TableName
+	}
+	return ""
+}
+
+func (x *InsertDescriptor) GetColumns() []*InsertColumnDescriptor {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *InsertDescriptor) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *InsertDescriptor) GetBatchSize() uint64 {
+	if x != nil {
+		return x.BatchSize
+	}
+	return 0
+}
+
+func (x *InsertDescriptor) GetMethod() InsertMethod {
+	if x != nil {
+		return x.Method
+	}
+	return InsertMethod_INSERT_METHOD_PLAIN_QUERY
+}
+
+func (x *InsertDescriptor) GetDbSpecific() *Value_Struct {
+	if x != nil {
+		return x.DbSpecific
+	}
+	return nil
+}
+
 // *
 // StepUnitDescriptor represents a single unit of work.
-// It can be a table creation operation, a query execution operation, or a transaction execution operation.
+// It can be a table creation operation, a query execution operation, a transaction execution operation
+// or a table population operation.
 // It also specifies whether to execute this operation asynchronously.
 type StepUnitDescriptor struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -588,6 +810,7 @@ type StepUnitDescriptor struct {
 	//	*StepUnitDescriptor_CreateTable
 	//	*StepUnitDescriptor_Query
 	//	*StepUnitDescriptor_Transaction
+	//	*StepUnitDescriptor_Insert
 	Type isStepUnitDescriptor_Type `protobuf_oneof:"type"`
 	// * Whether to execute this operation asynchronously
 	Async         bool `protobuf:"varint,100,opt,name=async,proto3" json:"async,omitempty"`
@@ -597,7 +820,7 @@ type StepUnitDescriptor struct {
 
 func (x *StepUnitDescriptor) Reset() {
 	*x = StepUnitDescriptor{}
-	mi := &file_descriptor_proto_msgTypes[6]
+	mi := &file_descriptor_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -609,7 +832,7 @@ func (x *StepUnitDescriptor) String() string {
 func (*StepUnitDescriptor) ProtoMessage() {}
 
 func (x *StepUnitDescriptor) ProtoReflect() protoreflect.Message {
-	mi := &file_descriptor_proto_msgTypes[6]
+	mi := &file_descriptor_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -622,7 +845,7 @@ func (x *StepUnitDescriptor) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StepUnitDescriptor.ProtoReflect.Descriptor instead.
 func (*StepUnitDescriptor) Descriptor() ([]byte, []int) {
-	return file_descriptor_proto_rawDescGZIP(), []int{6}
+	return file_descriptor_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *StepUnitDescriptor) GetType() isStepUnitDescriptor_Type {
@@ -659,6 +882,15 @@ func (x *StepUnitDescriptor) GetTransaction() *TransactionDescriptor {
 	return nil
 }
 
+func (x *StepUnitDescriptor) GetInsert() *InsertDescriptor {
+	if x != nil {
+		if x, ok := x.Type.(*StepUnitDescriptor_Insert); ok {
+			return x.Insert
+		}
+	}
+	return nil
+}
+
 func (x *StepUnitDescriptor) GetAsync() bool {
 	if x != nil {
 		return x.Async
@@ -685,12 +917,19 @@ type StepUnitDescriptor_Transaction struct {
 	Transaction *TransactionDescriptor `protobuf:"bytes,4,opt,name=transaction,proto3,oneof"`
 }
 
+type StepUnitDescriptor_Insert struct {
+	// * Table population operation
+	Insert *InsertDescriptor `protobuf:"bytes,5,opt,name=insert,proto3,oneof"`
+}
+
 func (*StepUnitDescriptor_CreateTable) isStepUnitDescriptor_Type() {}
 
 func (*StepUnitDescriptor_Query) isStepUnitDescriptor_Type() {}
 
 func (*StepUnitDescriptor_Transaction) isStepUnitDescriptor_Type() {}
 
+func (*StepUnitDescriptor_Insert) isStepUnitDescriptor_Type() {}
+
 // *
 // StepDescriptor represents a logical step in a benchmark.
 // It contains a list of operations to perform in this step.
@@ -708,7 +947,7 @@ type StepDescriptor struct {
 
 func (x *StepDescriptor) Reset() {
 	*x = StepDescriptor{}
-	mi := &file_descriptor_proto_msgTypes[7]
+	mi := &file_descriptor_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -720,7 +959,7 @@ func (x *StepDescriptor) String() string {
 func (*StepDescriptor) ProtoMessage() {}
 
 func (x *StepDescriptor) ProtoReflect() protoreflect.Message {
-	mi := &file_descriptor_proto_msgTypes[7]
+	mi := &file_descriptor_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -733,7 +972,7 @@ func (x *StepDescriptor) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StepDescriptor.ProtoReflect.Descriptor instead.
 func (*StepDescriptor) Descriptor() ([]byte, []int) {
-	return file_descriptor_proto_rawDescGZIP(), []int{7}
+	return file_descriptor_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *StepDescriptor) GetName() string {
@@ -771,7 +1010,7 @@ type BenchmarkDescriptor struct {
 
 func (x *BenchmarkDescriptor) Reset() {
 	*x = BenchmarkDescriptor{}
-	mi := &file_descriptor_proto_msgTypes[8]
+	mi := &file_descriptor_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -783,7 +1022,7 @@ func (x *BenchmarkDescriptor) String() string {
 func (*BenchmarkDescriptor) ProtoMessage() {}
 
 func (x *BenchmarkDescriptor) ProtoReflect() protoreflect.Message {
-	mi := &file_descriptor_proto_msgTypes[8]
+	mi := &file_descriptor_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -796,7 +1035,7 @@ func (x *BenchmarkDescriptor) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BenchmarkDescriptor.ProtoReflect.Descriptor instead.
 func (*BenchmarkDescriptor) Descriptor() ([]byte, []int) {
-	return file_descriptor_proto_rawDescGZIP(), []int{8}
+	return file_descriptor_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *BenchmarkDescriptor) GetName() string {
@@ -866,11 +1105,28 @@ const file_descriptor_proto_rawDesc = "" +
 	"\aqueries\x18\x03 \x03(\v2\x18.stroppy.QueryDescriptorB\x0f\xfaB\f\x92\x01\t\b\x01\"\x05\x8a\x01\x02\x10\x01R\aqueries\x12\x1d\n" +
 	"\x05count\x18\x04 \x01(\x04B\a\xfaB\x042\x02 \x00R\x05count\x126\n" +
 	"\vdb_specific\x18\x05 \x01(\v2\x15.stroppy.Value.StructR\n" +
-	"dbSpecific\"\xec\x01\n" +
+	"dbSpecific\"\xba\x01\n" +
+	"\x16InsertColumnDescriptor\x12\x1b\n" +
+	"\x04name\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x04name\x12K\n" +
+	"\x0fgeneration_rule\x18\x02 \x01(\v2\x18.stroppy.Generation.RuleB\b\xfaB\x05\x8a\x01\x02\x10\x01R\x0egenerationRule\x126\n" +
+	"\vdb_specific\x18\x03 \x01(\v2\x15.stroppy.Value.StructR\n" +
+	"dbSpecific\"\xd2\x02\n" +
+	"\x10InsertDescriptor\x12\x1b\n" +
+	"\x04name\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x04name\x12&\n" +
+	"\n" +
+	"table_name\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\ttableName\x12J\n" +
+	"\acolumns\x18\x03 \x03(\v2\x1f.stroppy.InsertColumnDescriptorB\x0f\xfaB\f\x92\x01\t\b\x01\"\x05\x8a\x01\x02\x10\x01R\acolumns\x12\x1d\n" +
+	"\x05count\x18\x04 \x01(\x04B\a\xfaB\x042\x02 \x00R\x05count\x12\x1d\n" +
+	"\n" +
+	"batch_size\x18\x05 \x01(\x04R\tbatchSize\x127\n" +
+	"\x06method\x18\x06 \x01(\x0e2\x15.stroppy.InsertMethodB\b\xfaB\x05\x82\x01\x02\x10\x01R\x06method\x126\n" +
+	"\vdb_specific\x18\a \x01(\v2\x15.stroppy.Value.StructR\n" +
+	"dbSpecific\"\xa1\x02\n" +
 	"\x12StepUnitDescriptor\x12=\n" +
 	"\fcreate_table\x18\x01 \x01(\v2\x18.stroppy.TableDescriptorH\x00R\vcreateTable\x120\n" +
 	"\x05query\x18\x02 \x01(\v2\x18.stroppy.QueryDescriptorH\x00R\x05query\x12B\n" +
-	"\vtransaction\x18\x04 \x01(\v2\x1e.stroppy.TransactionDescriptorH\x00R\vtransaction\x12\x14\n" +
+	"\vtransaction\x18\x04 \x01(\v2\x1e.stroppy.TransactionDescriptorH\x00R\vtransaction\x123\n" +
+	"\x06insert\x18\x05 \x01(\v2\x19.stroppy.InsertDescriptorH\x00R\x06insert\x12\x14\n" +
 	"\x05async\x18d \x01(\bR\x05asyncB\v\n" +
 	"\x04type\x12\x03\xf8B\x01\"\x87\x01\n" +
 	"\x0eStepDescriptor\x12\x1b\n" +
@@ -885,7 +1141,11 @@ const file_descriptor_proto_rawDesc = "" +
 	"#TX_ISOLATION_LEVEL_READ_UNCOMMITTED\x10\x01\x12%\n" +
 	"!TX_ISOLATION_LEVEL_READ_COMMITTED\x10\x02\x12&\n" +
 	"\"TX_ISOLATION_LEVEL_REPEATABLE_READ\x10\x03\x12#\n" +
-	"\x1fTX_ISOLATION_LEVEL_SERIALIZABLE\x10\x04B.Z,github.com/stroppy-io/stroppy-core/pkg/protob\x06proto3"
+	"\x1fTX_ISOLATION_LEVEL_SERIALIZABLE\x10\x04*b\n" +
+	"\fInsertMethod\x12\x1d\n" +
+	"\x19INSERT_METHOD_PLAIN_QUERY\x10\x00\x12\x1b\n" +
+	"\x17INSERT_METHOD_COPY_FROM\x10\x01\x12\x16\n" +
+	"\x12INSERT_METHOD_BULK\x10\x02B.Z,github.com/stroppy-io/stroppy-core/pkg/protob\x06proto3"
 
 var (
 	file_descriptor_proto_rawDescOnce sync.Once
@@ -899,44 +1159,53 @@ func file_descriptor_proto_rawDescGZIP() []byte {
 	return file_descriptor_proto_rawDescData
 }
 
-var file_descriptor_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_descriptor_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_descriptor_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_descriptor_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_descriptor_proto_goTypes = []any{
-	(TxIsolationLevel)(0),         // 0: stroppy.TxIsolationLevel
-	(*IndexDescriptor)(nil),       // 1: stroppy.IndexDescriptor
-	(*ColumnDescriptor)(nil),      // 2: stroppy.ColumnDescriptor
-	(*TableDescriptor)(nil),       // 3: stroppy.TableDescriptor
-	(*QueryParamDescriptor)(nil),  // 4: stroppy.QueryParamDescriptor
-	(*QueryDescriptor)(nil),       // 5: stroppy.QueryDescriptor
-	(*TransactionDescriptor)(nil), // 6: stroppy.TransactionDescriptor
-	(*StepUnitDescriptor)(nil),    // 7: stroppy.StepUnitDescriptor
-	(*StepDescriptor)(nil),        // 8: stroppy.StepDescriptor
-	(*BenchmarkDescriptor)(nil),   // 9: stroppy.BenchmarkDescriptor
-	(*Value_Struct)(nil),          // 10: stroppy.Value.Struct
-	(*Generation_Rule)(nil),       // 11: stroppy.Generation.Rule
+	(TxIsolationLevel)(0),          // 0: stroppy.TxIsolationLevel
+	(InsertMethod)(0),              // 1: stroppy.InsertMethod
+	(*IndexDescriptor)(nil),        // 2: stroppy.IndexDescriptor
+	(*ColumnDescriptor)(nil),       // 3: stroppy.ColumnDescriptor
+	(*TableDescriptor)(nil),        // 4: stroppy.TableDescriptor
+	(*QueryParamDescriptor)(nil),   // 5: stroppy.QueryParamDescriptor
+	(*QueryDescriptor)(nil),        // 6: stroppy.QueryDescriptor
+	(*TransactionDescriptor)(nil),  // 7: stroppy.TransactionDescriptor
+	(*InsertColumnDescriptor)(nil), // 8: stroppy.InsertColumnDescriptor
+	(*InsertDescriptor)(nil),       // 9: stroppy.InsertDescriptor
+	(*StepUnitDescriptor)(nil),     // 10: stroppy.StepUnitDescriptor
+	(*StepDescriptor)(nil),         // 11: stroppy.StepDescriptor
+	(*BenchmarkDescriptor)(nil),    // 12: stroppy.BenchmarkDescriptor
+	(*Value_Struct)(nil),           // 13: stroppy.Value.Struct
+	(*Generation_Rule)(nil),        // 14: stroppy.Generation.Rule
 }
 var file_descriptor_proto_depIdxs = []int32{
-	10, // 0: stroppy.IndexDescriptor.db_specific:type_name -> stroppy.Value.Struct
-	1,  // 1: stroppy.TableDescriptor.table_indexes:type_name -> stroppy.IndexDescriptor
-	10, // 2: stroppy.TableDescriptor.db_specific:type_name -> stroppy.Value.Struct
-	2,  // 3: stroppy.TableDescriptor.columns:type_name -> stroppy.ColumnDescriptor
-	11, // 4: stroppy.QueryParamDescriptor.generation_rule:type_name -> stroppy.Generation.Rule
-	10, // 5: stroppy.QueryParamDescriptor.db_specific:type_name -> stroppy.Value.Struct
-	4,  // 6: stroppy.QueryDescriptor.params:type_name -> stroppy.QueryParamDescriptor
-	10, // 7: stroppy.QueryDescriptor.db_specific:type_name -> stroppy.Value.Struct
+	13, // 0: stroppy.IndexDescriptor.db_specific:type_name -> stroppy.Value.Struct
+	2,  // 1: stroppy.TableDescriptor.table_indexes:type_name -> stroppy.IndexDescriptor
+	13, // 2: stroppy.TableDescriptor.db_specific:type_name -> stroppy.Value.Struct
+	3,  // 3: stroppy.TableDescriptor.columns:type_name -> stroppy.ColumnDescriptor
+	14, // 4: stroppy.QueryParamDescriptor.generation_rule:type_name -> stroppy.Generation.Rule
+	13, // 5: stroppy.QueryParamDescriptor.db_specific:type_name -> stroppy.Value.Struct
+	5,  // 6: stroppy.QueryDescriptor.params:type_name -> stroppy.QueryParamDescriptor
+	13, // 7: stroppy.QueryDescriptor.db_specific:type_name -> stroppy.Value.Struct
 	0,  // 8: stroppy.TransactionDescriptor.isolation_level:type_name -> stroppy.TxIsolationLevel
-	5,  // 9: stroppy.TransactionDescriptor.queries:type_name -> stroppy.QueryDescriptor
-	10, // 10: stroppy.TransactionDescriptor.db_specific:type_name -> stroppy.Value.Struct
-	3,  // 11: stroppy.StepUnitDescriptor.create_table:type_name -> stroppy.TableDescriptor
-	5,  // 12: stroppy.StepUnitDescriptor.query:type_name -> stroppy.QueryDescriptor
-	6,  // 13: stroppy.StepUnitDescriptor.transaction:type_name -> stroppy.TransactionDescriptor
-	7,  // 14: stroppy.StepDescriptor.units:type_name -> stroppy.StepUnitDescriptor
-	8,  // 15: stroppy.BenchmarkDescriptor.steps:type_name -> stroppy.StepDescriptor
-	16, // [16:16] is the sub-list for method output_type
-	16, // [16:16] is the sub-list for method input_type
-	16, // [16:16] is the sub-list for extension type_name
-	16, // [16:16] is the sub-list for extension extendee
-	0,  // [0:16] is the sub-list for field type_name
+	6,  // 9: stroppy.TransactionDescriptor.queries:type_name -> stroppy.QueryDescriptor
+	13, // 10: stroppy.TransactionDescriptor.db_specific:type_name -> stroppy.Value.Struct
+	14, // 11: stroppy.InsertColumnDescriptor.generation_rule:type_name -> stroppy.Generation.Rule
+	13, // 12: stroppy.InsertColumnDescriptor.db_specific:type_name -> stroppy.Value.Struct
+	8,  // 13: stroppy.InsertDescriptor.columns:type_name -> stroppy.InsertColumnDescriptor
+	1,  // 14: stroppy.InsertDescriptor.method:type_name -> stroppy.InsertMethod
+	13, // 15: stroppy.InsertDescriptor.db_specific:type_name -> stroppy.Value.Struct
+	4,  // 16: stroppy.StepUnitDescriptor.create_table:type_name -> stroppy.TableDescriptor
+	6,  // 17: stroppy.StepUnitDescriptor.query:type_name -> stroppy.QueryDescriptor
+	7,  // 18: stroppy.StepUnitDescriptor.transaction:type_name -> stroppy.TransactionDescriptor
+	9,  // 19: stroppy.StepUnitDescriptor.insert:type_name -> stroppy.InsertDescriptor
+	10, // 20: stroppy.StepDescriptor.units:type_name -> stroppy.StepUnitDescriptor
+	11, // 21: stroppy.BenchmarkDescriptor.steps:type_name -> stroppy.StepDescriptor
+	22, // [22:22] is the sub-list for method output_type
+	22, // [22:22] is the sub-list for method input_type
+	22, // [22:22] is the sub-list for extension type_name
+	22, // [22:22] is the sub-list for extension extendee
+	0,  // [0:22] is the sub-list for field type_name
 }
 
 func init() { file_descriptor_proto_init() }
@@ -946,18 +1215,19 @@ func file_descriptor_proto_init() {
 	}
 	file_common_proto_init()
 	file_descriptor_proto_msgTypes[0].OneofWrappers = []any{}
-	file_descriptor_proto_msgTypes[6].OneofWrappers = []any{
+	file_descriptor_proto_msgTypes[8].OneofWrappers = []any{
 		(*StepUnitDescriptor_CreateTable)(nil),
 		(*StepUnitDescriptor_Query)(nil),
 		(*StepUnitDescriptor_Transaction)(nil),
+		(*StepUnitDescriptor_Insert)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_descriptor_proto_rawDesc), len(file_descriptor_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   9,
+			NumEnums:      2,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -1157,6 +1157,419 @@ var _ interface {
 	ErrorName() string
 } = TransactionDescriptorValidationError{}
 
+// Validate checks the field values on InsertColumnDescriptor with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InsertColumnDescriptor) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InsertColumnDescriptor with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InsertColumnDescriptorMultiError, or nil if none found.
+func (m *InsertColumnDescriptor) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InsertColumnDescriptor) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := InsertColumnDescriptorValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetGenerationRule() == nil {
+		err := InsertColumnDescriptorValidationError{
+			field:  "GenerationRule",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetGenerationRule()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InsertColumnDescriptorValidationError{
+					field:  "GenerationRule",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InsertColumnDescriptorValidationError{
+					field:  "GenerationRule",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetGenerationRule()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InsertColumnDescriptorValidationError{
+				field:  "GenerationRule",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetDbSpecific()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InsertColumnDescriptorValidationError{
+					field:  "DbSpecific",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InsertColumnDescriptorValidationError{
+					field:  "DbSpecific",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDbSpecific()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InsertColumnDescriptorValidationError{
+				field:  "DbSpecific",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return InsertColumnDescriptorMultiError(errors)
+	}
+
+	return nil
+}
+
+// InsertColumnDescriptorMultiError is an error wrapping multiple validation
+// errors returned by InsertColumnDescriptor.ValidateAll() if the designated
+// constraints aren't met.
+type InsertColumnDescriptorMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InsertColumnDescriptorMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InsertColumnDescriptorMultiError) AllErrors() []error { return m }
+
+// InsertColumnDescriptorValidationError is the validation error returned by
+// InsertColumnDescriptor.Validate if the designated constraints aren't met.
+type InsertColumnDescriptorValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InsertColumnDescriptorValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InsertColumnDescriptorValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InsertColumnDescriptorValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InsertColumnDescriptorValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InsertColumnDescriptorValidationError) ErrorName() string {
+	return "InsertColumnDescriptorValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InsertColumnDescriptorValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInsertColumnDescriptor.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InsertColumnDescriptorValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InsertColumnDescriptorValidationError{}
+
+// Validate checks the field values on InsertDescriptor with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *InsertDescriptor) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InsertDescriptor with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InsertDescriptorMultiError, or nil if none found.
+func (m *InsertDescriptor) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InsertDescriptor) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := InsertDescriptorValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetTableName()) < 1 {
+		err := InsertDescriptorValidationError{
+			field:  "TableName",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetColumns()) < 1 {
+		err := InsertDescriptorValidationError{
+			field:  "Columns",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetColumns() {
+		_, _ = idx, item
+
+		if item == nil {
+			err := InsertDescriptorValidationError{
+				field:  fmt.Sprintf("Columns[%v]", idx),
+				reason: "value is required",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, InsertDescriptorValidationError{
+						field:  fmt.Sprintf("Columns[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, InsertDescriptorValidationError{
+						field:  fmt.Sprintf("Columns[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return InsertDescriptorValidationError{
+					field:  fmt.Sprintf("Columns[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.GetCount() <= 0 {
+		err := InsertDescriptorValidationError{
+			field:  "Count",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for BatchSize
+
+	if _, ok := InsertMethod_name[int32(m.GetMethod())]; !ok {
+		err := InsertDescriptorValidationError{
+			field:  "Method",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetDbSpecific()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InsertDescriptorValidationError{
+					field:  "DbSpecific",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InsertDescriptorValidationError{
+					field:  "DbSpecific",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDbSpecific()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InsertDescriptorValidationError{
+				field:  "DbSpecific",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return InsertDescriptorMultiError(errors)
+	}
+
+	return nil
+}
+
+// InsertDescriptorMultiError is an error wrapping multiple validation errors
+// returned by InsertDescriptor.ValidateAll() if the designated constraints
+// aren't met.
+type InsertDescriptorMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InsertDescriptorMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InsertDescriptorMultiError) AllErrors() []error { return m }
+
+// InsertDescriptorValidationError is the validation error returned by
+// InsertDescriptor.Validate if the designated constraints aren't met.
+type InsertDescriptorValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InsertDescriptorValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InsertDescriptorValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InsertDescriptorValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InsertDescriptorValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InsertDescriptorValidationError) ErrorName() string { return "InsertDescriptorValidationError" }
+
+// Error satisfies the builtin error interface
+func (e InsertDescriptorValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInsertDescriptor.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InsertDescriptorValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InsertDescriptorValidationError{}
+
 // Validate checks the field values on StepUnitDescriptor with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -1309,6 +1722,48 @@ func (m *StepUnitDescriptor) validate(all bool) error {
 			}
 		}
 
+	case *StepUnitDescriptor_Insert:
+		if v == nil {
+			err := StepUnitDescriptorValidationError{
+				field:  "Type",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofTypePresent = true
+
+		if all {
+			switch v := interface{}(m.GetInsert()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, StepUnitDescriptorValidationError{
+						field:  "Insert",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, StepUnitDescriptorValidationError{
+						field:  "Insert",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetInsert()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StepUnitDescriptorValidationError{
+					field:  "Insert",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
 	default:
 		_ = v // ensures v is used
 	}
@@ -9,7 +9,9 @@ package proto
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -22,6 +24,264 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// *
+// DriverErrorKind classifies transaction failures.
+type DriverErrorKind int32
+
+const (
+	// * Error is absent or not classified
+	DriverErrorKind_DRIVER_ERROR_KIND_UNSPECIFIED DriverErrorKind = 0
+	// * Transaction was aborted because of concurrent modification
+	DriverErrorKind_DRIVER_ERROR_KIND_SERIALIZATION_FAILURE DriverErrorKind = 1
+	// * Transaction was aborted to resolve a deadlock
+	DriverErrorKind_DRIVER_ERROR_KIND_DEADLOCK DriverErrorKind = 2
+	// * Unique, foreign key, check or not null constraint was violated
+	DriverErrorKind_DRIVER_ERROR_KIND_CONSTRAINT_VIOLATION DriverErrorKind = 3
+	// * Transaction or query timed out
+	DriverErrorKind_DRIVER_ERROR_KIND_TIMEOUT DriverErrorKind = 4
+	// * Connection to the database was lost
+	DriverErrorKind_DRIVER_ERROR_KIND_CONNECTION_LOST DriverErrorKind = 5
+)
+
+// Enum value maps for DriverErrorKind.
+var (
+	DriverErrorKind_name = map[int32]string{
+		0: "DRIVER_ERROR_KIND_UNSPECIFIED",
+		1: "DRIVER_ERROR_KIND_SERIALIZATION_FAILURE",
+		2: "DRIVER_ERROR_KIND_DEADLOCK",
+		3: "DRIVER_ERROR_KIND_CONSTRAINT_VIOLATION",
+		4: "DRIVER_ERROR_KIND_TIMEOUT",
+		5: "DRIVER_ERROR_KIND_CONNECTION_LOST",
+	}
+	DriverErrorKind_value = map[string]int32{
+		"DRIVER_ERROR_KIND_UNSPECIFIED":           0,
+		"DRIVER_ERROR_KIND_SERIALIZATION_FAILURE": 1,
+		"DRIVER_ERROR_KIND_DEADLOCK":              2,
+		"DRIVER_ERROR_KIND_CONSTRAINT_VIOLATION":  3,
+		"DRIVER_ERROR_KIND_TIMEOUT":               4,
+		"DRIVER_ERROR_KIND_CONNECTION_LOST":       5,
+	}
+)
+
+func (x DriverErrorKind) Enum() *DriverErrorKind {
+	p := new(DriverErrorKind)
+	*p = x
+	return p
+}
+
+func (x DriverErrorKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DriverErrorKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_plugins_proto_enumTypes[0].Descriptor()
+}
+
+func (DriverErrorKind) Type() protoreflect.EnumType {
+	return &file_plugins_proto_enumTypes[0]
+}
+
+func (x DriverErrorKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DriverErrorKind.Descriptor instead.
+func (DriverErrorKind) EnumDescriptor() ([]byte, []int) {
+	return file_plugins_proto_rawDescGZIP(), []int{0}
+}
+
+type DriverCapabilities_UnitType int32
+
+const (
+	DriverCapabilities_UNIT_TYPE_UNSPECIFIED  DriverCapabilities_UnitType = 0
+	DriverCapabilities_UNIT_TYPE_CREATE_TABLE DriverCapabilities_UnitType = 1
+	DriverCapabilities_UNIT_TYPE_QUERY        DriverCapabilities_UnitType = 2
+	DriverCapabilities_UNIT_TYPE_TRANSACTION  DriverCapabilities_UnitType = 3
+	DriverCapabilities_UNIT_TYPE_INSERT       DriverCapabilities_UnitType = 4
+)
+
+// Enum value maps for DriverCapabilities_UnitType.
+var (
+	DriverCapabilities_UnitType_name = map[int32]string{
+		0: "UNIT_TYPE_UNSPECIFIED",
+		1: "UNIT_TYPE_CREATE_TABLE",
+		2: "UNIT_TYPE_QUERY",
+		3: "UNIT_TYPE_TRANSACTION",
+		4: "UNIT_TYPE_INSERT",
+	}
+	DriverCapabilities_UnitType_value = map[string]int32{
+		"UNIT_TYPE_UNSPECIFIED":  0,
+		"UNIT_TYPE_CREATE_TABLE": 1,
+		"UNIT_TYPE_QUERY":        2,
+		"UNIT_TYPE_TRANSACTION":  3,
+		"UNIT_TYPE_INSERT":       4,
+	}
+)
+
+func (x DriverCapabilities_UnitType) Enum() *DriverCapabilities_UnitType {
+	p := new(DriverCapabilities_UnitType)
+	*p = x
+	return p
+}
+
+func (x DriverCapabilities_UnitType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DriverCapabilities_UnitType) Descriptor() protoreflect.EnumDescriptor {
+	return file_plugins_proto_enumTypes[1].Descriptor()
+}
+
+func (DriverCapabilities_UnitType) Type() protoreflect.EnumType {
+	return &file_plugins_proto_enumTypes[1]
+}
+
+func (x DriverCapabilities_UnitType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DriverCapabilities_UnitType.Descriptor instead.
+func (DriverCapabilities_UnitType) EnumDescriptor() ([]byte, []int) {
+	return file_plugins_proto_rawDescGZIP(), []int{10, 0}
+}
+
+type DriverCapabilities_ValueType int32
+
+const (
+	DriverCapabilities_VALUE_TYPE_UNSPECIFIED DriverCapabilities_ValueType = 0
+	DriverCapabilities_VALUE_TYPE_NULL        DriverCapabilities_ValueType = 1
+	DriverCapabilities_VALUE_TYPE_INT32       DriverCapabilities_ValueType = 2
+	DriverCapabilities_VALUE_TYPE_UINT32      DriverCapabilities_ValueType = 3
+	DriverCapabilities_VALUE_TYPE_INT64       DriverCapabilities_ValueType = 4
+	DriverCapabilities_VALUE_TYPE_UINT64      DriverCapabilities_ValueType = 5
+	DriverCapabilities_VALUE_TYPE_FLOAT       DriverCapabilities_ValueType = 6
+	DriverCapabilities_VALUE_TYPE_DOUBLE      DriverCapabilities_ValueType = 7
+	DriverCapabilities_VALUE_TYPE_STRING      DriverCapabilities_ValueType = 8
+	DriverCapabilities_VALUE_TYPE_BOOL        DriverCapabilities_ValueType = 9
+	DriverCapabilities_VALUE_TYPE_DECIMAL     DriverCapabilities_ValueType = 10
+	DriverCapabilities_VALUE_TYPE_UUID        DriverCapabilities_ValueType = 11
+	DriverCapabilities_VALUE_TYPE_DATETIME    DriverCapabilities_ValueType = 12
+	DriverCapabilities_VALUE_TYPE_STRUCT      DriverCapabilities_ValueType = 13
+	DriverCapabilities_VALUE_TYPE_LIST        DriverCapabilities_ValueType = 14
+)
+
+// Enum value maps for DriverCapabilities_ValueType.
+var (
+	DriverCapabilities_ValueType_name = map[int32]string{
+		0:  "VALUE_TYPE_UNSPECIFIED",
+		1:  "VALUE_TYPE_NULL",
+		2:  "VALUE_TYPE_INT32",
+		3:  "VALUE_TYPE_UINT32",
+		4:  "VALUE_TYPE_INT64",
+		5:  "VALUE_TYPE_UINT64",
+		6:  "VALUE_TYPE_FLOAT",
+		7:  "VALUE_TYPE_DOUBLE",
+		8:  "VALUE_TYPE_STRING",
+		9:  "VALUE_TYPE_BOOL",
+		10: "VALUE_TYPE_DECIMAL",
+		11: "VALUE_TYPE_UUID",
+		12: "VALUE_TYPE_DATETIME",
+		13: "VALUE_TYPE_STRUCT",
+		14: "VALUE_TYPE_LIST",
+	}
+	DriverCapabilities_ValueType_value = map[string]int32{
+		"VALUE_TYPE_UNSPECIFIED": 0,
+		"VALUE_TYPE_NULL":        1,
+		"VALUE_TYPE_INT32":       2,
+		"VALUE_TYPE_UINT32":      3,
+		"VALUE_TYPE_INT64":       4,
+		"VALUE_TYPE_UINT64":      5,
+		"VALUE_TYPE_FLOAT":       6,
+		"VALUE_TYPE_DOUBLE":      7,
+		"VALUE_TYPE_STRING":      8,
+		"VALUE_TYPE_BOOL":        9,
+		"VALUE_TYPE_DECIMAL":     10,
+		"VALUE_TYPE_UUID":        11,
+		"VALUE_TYPE_DATETIME":    12,
+		"VALUE_TYPE_STRUCT":      13,
+		"VALUE_TYPE_LIST":        14,
+	}
+)
+
+func (x DriverCapabilities_ValueType) Enum() *DriverCapabilities_ValueType {
+	p := new(DriverCapabilities_ValueType)
+	*p = x
+	return p
+}
+
+func (x DriverCapabilities_ValueType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DriverCapabilities_ValueType) Descriptor() protoreflect.EnumDescriptor {
+	return file_plugins_proto_enumTypes[2].Descriptor()
+}
+
+func (DriverCapabilities_ValueType) Type() protoreflect.EnumType {
+	return &file_plugins_proto_enumTypes[2]
+}
+
+func (x DriverCapabilities_ValueType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DriverCapabilities_ValueType.Descriptor instead.
+func (DriverCapabilities_ValueType) EnumDescriptor() ([]byte, []int) {
+	return file_plugins_proto_rawDescGZIP(), []int{10, 1}
+}
+
+type StepDecision_Action int32
+
+const (
+	// * Start the step
+	StepDecision_ACTION_PROCEED StepDecision_Action = 0
+	// * Ask again after poll_interval
+	StepDecision_ACTION_DELAY StepDecision_Action = 1
+	// * Abort the run
+	StepDecision_ACTION_ABORT StepDecision_Action = 2
+)
+
+// Enum value maps for StepDecision_Action.
+var (
+	StepDecision_Action_name = map[int32]string{
+		0: "ACTION_PROCEED",
+		1: "ACTION_DELAY",
+		2: "ACTION_ABORT",
+	}
+	StepDecision_Action_value = map[string]int32{
+		"ACTION_PROCEED": 0,
+		"ACTION_DELAY":   1,
+		"ACTION_ABORT":   2,
+	}
+)
+
+func (x StepDecision_Action) Enum() *StepDecision_Action {
+	p := new(StepDecision_Action)
+	*p = x
+	return p
+}
+
+func (x StepDecision_Action) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StepDecision_Action) Descriptor() protoreflect.EnumDescriptor {
+	return file_plugins_proto_enumTypes[3].Descriptor()
+}
+
+func (StepDecision_Action) Type() protoreflect.EnumType {
+	return &file_plugins_proto_enumTypes[3]
+}
+
+func (x StepDecision_Action) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StepDecision_Action.Descriptor instead.
+func (StepDecision_Action) EnumDescriptor() ([]byte, []int) {
+	return file_plugins_proto_rawDescGZIP(), []int{16, 0}
+}
+
 // *
 // UnitBuildContext provides the context needed to build a unit from a StepUnitDescriptor.
 type UnitBuildContext struct {
@@ -144,6 +404,7 @@ type DriverTransaction struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	Queries        []*DriverQuery         `protobuf:"bytes,1,rep,name=queries,proto3" json:"queries,omitempty"`
 	IsolationLevel TxIsolationLevel       `protobuf:"varint,2,opt,name=isolation_level,json=isolationLevel,proto3,enum=stroppy.TxIsolationLevel" json:"isolation_level,omitempty"`
+	BulkInsert     *DriverBulkInsert      `protobuf:"bytes,3,opt,name=bulk_insert,json=bulkInsert,proto3" json:"bulk_insert,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -192,6 +453,100 @@ func (x *DriverTransaction) GetIsolationLevel() TxIsolationLevel {
 	return TxIsolationLevel_TX_ISOLATION_LEVEL_UNSPECIFIED
 }
 
+func (x *DriverTransaction) GetBulkInsert() *DriverBulkInsert {
+	if x != nil {
+		return x.BulkInsert
+	}
+	return nil
+}
+
+// *
+// DriverBulkInsert represents a batch of rows that the driver writes into a table
+// with COPY-style or driver-defined bulk API.
+type DriverBulkInsert struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	TableName     string                 `protobuf:"bytes,2,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
+	Columns       []string               `protobuf:"bytes,3,rep,name=columns,proto3" json:"columns,omitempty"`
+	Rows          []*Value_List          `protobuf:"bytes,4,rep,name=rows,proto3" json:"rows,omitempty"`
+	Method        InsertMethod           `protobuf:"varint,5,opt,name=method,proto3,enum=stroppy.InsertMethod" json:"method,omitempty"`
+	DbSpecific    *Value_Struct          `protobuf:"bytes,6,opt,name=db_specific,json=dbSpecific,proto3" json:"db_specific,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DriverBulkInsert) Reset() {
+	*x = DriverBulkInsert{}
+	mi := &file_plugins_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DriverBulkInsert) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DriverBulkInsert) ProtoMessage() {}
+
+func (x *DriverBulkInsert) ProtoReflect() protoreflect.Message {
+	mi := &file_plugins_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DriverBulkInsert.ProtoReflect.Descriptor instead.
+func (*DriverBulkInsert) Descriptor() ([]byte, []int) {
+	return file_plugins_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DriverBulkInsert) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DriverBulkInsert) GetTableName() string {
+	if x != nil {
+		return x.TableName
+	}
+	return ""
+}
+
+func (x *DriverBulkInsert) GetColumns() []string {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *DriverBulkInsert) GetRows() []*Value_List {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+func (x *DriverBulkInsert) GetMethod() InsertMethod {
+	if x != nil {
+		return x.Method
+	}
+	return InsertMethod_INSERT_METHOD_PLAIN_QUERY
+}
+
+func (x *DriverBulkInsert) GetDbSpecific() *Value_Struct {
+	if x != nil {
+		return x.DbSpecific
+	}
+	return nil
+}
+
 // *
 // DriverTransactionList is a list of transactions.
 type DriverTransactionList struct {
@@ -203,7 +558,7 @@ type DriverTransactionList struct {
 
 func (x *DriverTransactionList) Reset() {
 	*x = DriverTransactionList{}
-	mi := &file_plugins_proto_msgTypes[3]
+	mi := &file_plugins_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -215,7 +570,7 @@ func (x *DriverTransactionList) String() string {
 func (*DriverTransactionList) ProtoMessage() {}
 
 func (x *DriverTransactionList) ProtoReflect() protoreflect.Message {
-	mi := &file_plugins_proto_msgTypes[3]
+	mi := &file_plugins_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -228,7 +583,7 @@ func (x *DriverTransactionList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DriverTransactionList.ProtoReflect.Descriptor instead.
 func (*DriverTransactionList) Descriptor() ([]byte, []int) {
-	return file_plugins_proto_rawDescGZIP(), []int{3}
+	return file_plugins_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *DriverTransactionList) GetTransactions() []*DriverTransaction {
@@ -238,113 +593,1714 @@ func (x *DriverTransactionList) GetTransactions() []*DriverTransaction {
 	return nil
 }
 
-var File_plugins_proto protoreflect.FileDescriptor
+// *
+// TransactionLogEntry is a record of the transaction log: a stream of length-delimited entries
+// capturing a workload to replay it through any driver.
+type TransactionLogEntry struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// * Time the transaction was sent to the driver
+	Time *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	// * Sent transaction
+	Transaction   *DriverTransaction `protobuf:"bytes,2,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_plugins_proto_rawDesc = "" +
-	"\n" +
-	"\rplugins.proto\x12\astroppy\x1a\fcommon.proto\x1a\fconfig.proto\x1a\x10descriptor.proto\x1a\x1bgoogle/protobuf/empty.proto\"s\n" +
-	"\x10UnitBuildContext\x12.\n" +
-	"\acontext\x18\x01 \x01(\v2\x14.stroppy.StepContextR\acontext\x12/\n" +
-	"\x04unit\x18\x02 \x01(\v2\x1b.stroppy.StepUnitDescriptorR\x04unit\"c\n" +
-	"\vDriverQuery\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
-	"\arequest\x18\x02 \x01(\tR\arequest\x12&\n" +
-	"\x06params\x18\x03 \x03(\v2\x0e.stroppy.ValueR\x06params\"\x87\x01\n" +
-	"\x11DriverTransaction\x12.\n" +
-	"\aqueries\x18\x01 \x03(\v2\x14.stroppy.DriverQueryR\aqueries\x12B\n" +
-	"\x0fisolation_level\x18\x02 \x01(\x0e2\x19.stroppy.TxIsolationLevelR\x0eisolationLevel\"W\n" +
-	"\x15DriverTransactionList\x12>\n" +
-	"\ftransactions\x18\x01 \x03(\v2\x1a.stroppy.DriverTransactionR\ftransactions2\x80\x03\n" +
-	"\fDriverPlugin\x12:\n" +
-	"\n" +
-	"Initialize\x12\x14.stroppy.StepContext\x1a\x16.google.protobuf.Empty\x12V\n" +
-	"\x19BuildTransactionsFromUnit\x12\x19.stroppy.UnitBuildContext\x1a\x1e.stroppy.DriverTransactionList\x12Z\n" +
-	"\x1fBuildTransactionsFromUnitStream\x12\x19.stroppy.UnitBuildContext\x1a\x1a.stroppy.DriverTransaction0\x01\x12D\n" +
-	"\x0eRunTransaction\x12\x1a.stroppy.DriverTransaction\x1a\x16.google.protobuf.Empty\x12:\n" +
-	"\bTeardown\x12\x16.google.protobuf.Empty\x1a\x16.google.protobuf.Empty2\xff\x01\n" +
-	"\rSidecarPlugin\x12:\n" +
-	"\n" +
-	"Initialize\x12\x14.stroppy.StepContext\x1a\x16.google.protobuf.Empty\x12;\n" +
-	"\vOnStepStart\x12\x14.stroppy.StepContext\x1a\x16.google.protobuf.Empty\x129\n" +
-	"\tOnStepEnd\x12\x14.stroppy.StepContext\x1a\x16.google.protobuf.Empty\x12:\n" +
-	"\bTeardown\x12\x16.google.protobuf.Empty\x1a\x16.google.protobuf.EmptyB.Z,github.com/stroppy-io/stroppy-core/pkg/protob\x06proto3"
+func (x *TransactionLogEntry) Reset() {
+	*x = TransactionLogEntry{}
+	mi := &file_plugins_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
 
-var (
-	file_plugins_proto_rawDescOnce sync.Once
-	file_plugins_proto_rawDescData []byte
-)
+func (x *TransactionLogEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-func file_plugins_proto_rawDescGZIP() []byte {
-	file_plugins_proto_rawDescOnce.Do(func() {
-		file_plugins_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_plugins_proto_rawDesc), len(file_plugins_proto_rawDesc)))
-	})
-	return file_plugins_proto_rawDescData
+func (*TransactionLogEntry) ProtoMessage() {}
+
+func (x *TransactionLogEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_plugins_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-var file_plugins_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
-var file_plugins_proto_goTypes = []any{
-	(*UnitBuildContext)(nil),      // 0: stroppy.UnitBuildContext
-	(*DriverQuery)(nil),           // 1: stroppy.DriverQuery
-	(*DriverTransaction)(nil),     // 2: stroppy.DriverTransaction
-	(*DriverTransactionList)(nil), // 3: stroppy.DriverTransactionList
-	(*StepContext)(nil),           // 4: stroppy.StepContext
-	(*StepUnitDescriptor)(nil),    // 5: stroppy.StepUnitDescriptor
-	(*Value)(nil),                 // 6: stroppy.Value
-	(TxIsolationLevel)(0),         // 7: stroppy.TxIsolationLevel
-	(*emptypb.Empty)(nil),         // 8: google.protobuf.Empty
+// Deprecated: Use TransactionLogEntry.ProtoReflect.Descriptor instead.
+func (*TransactionLogEntry) Descriptor() ([]byte, []int) {
+	return file_plugins_proto_rawDescGZIP(), []int{5}
 }
-var file_plugins_proto_depIdxs = []int32{
-	4,  // 0: stroppy.UnitBuildContext.context:type_name -> stroppy.StepContext
-	5,  // 1: stroppy.UnitBuildContext.unit:type_name -> stroppy.StepUnitDescriptor
-	6,  // 2: stroppy.DriverQuery.params:type_name -> stroppy.Value
-	1,  // 3: stroppy.DriverTransaction.queries:type_name -> stroppy.DriverQuery
-	7,  // 4: stroppy.DriverTransaction.isolation_level:type_name -> stroppy.TxIsolationLevel
-	2,  // 5: stroppy.DriverTransactionList.transactions:type_name -> stroppy.DriverTransaction
-	4,  // 6: stroppy.DriverPlugin.Initialize:input_type -> stroppy.StepContext
-	0,  // 7: stroppy.DriverPlugin.BuildTransactionsFromUnit:input_type -> stroppy.UnitBuildContext
-	0,  // 8: stroppy.DriverPlugin.BuildTransactionsFromUnitStream:input_type -> stroppy.UnitBuildContext
-	2,  // 9: stroppy.DriverPlugin.RunTransaction:input_type -> stroppy.DriverTransaction
-	8,  // 10: stroppy.DriverPlugin.Teardown:input_type -> google.protobuf.Empty
-	4,  // 11: stroppy.SidecarPlugin.Initialize:input_type -> stroppy.StepContext
-	4,  // 12: stroppy.SidecarPlugin.OnStepStart:input_type -> stroppy.StepContext
-	4,  // 13: stroppy.SidecarPlugin.OnStepEnd:input_type -> stroppy.StepContext
-	8,  // 14: stroppy.SidecarPlugin.Teardown:input_type -> google.protobuf.Empty
-	8,  // 15: stroppy.DriverPlugin.Initialize:output_type -> google.protobuf.Empty
-	3,  // 16: stroppy.DriverPlugin.BuildTransactionsFromUnit:output_type -> stroppy.DriverTransactionList
-	2,  // 17: stroppy.DriverPlugin.BuildTransactionsFromUnitStream:output_type -> stroppy.DriverTransaction
-	8,  // 18: stroppy.DriverPlugin.RunTransaction:output_type -> google.protobuf.Empty
-	8,  // 19: stroppy.DriverPlugin.Teardown:output_type -> google.protobuf.Empty
-	8,  // 20: stroppy.SidecarPlugin.Initialize:output_type -> google.protobuf.Empty
-	8,  // 21: stroppy.SidecarPlugin.OnStepStart:output_type -> google.protobuf.Empty
-	8,  // 22: stroppy.SidecarPlugin.OnStepEnd:output_type -> google.protobuf.Empty
-	8,  // 23: stroppy.SidecarPlugin.Teardown:output_type -> google.protobuf.Empty
-	15, // [15:24] is the sub-list for method output_type
-	6,  // [6:15] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+
+func (x *TransactionLogEntry) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
 }
 
-func init() { file_plugins_proto_init() }
-func file_plugins_proto_init() {
-	if File_plugins_proto != nil {
-		return
+func (x *TransactionLogEntry) GetTransaction() *DriverTransaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+// *
+// DriverTransactionResult is the outcome of a single transaction run by the driver.
+type DriverTransactionResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// * Sequence number of the transaction in RunTransactions stream or RunTransactionBatch list
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// * Time when the driver started the transaction
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// * Time when the driver finished the transaction
+	EndTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// * Error message, empty if the transaction succeeded
+	Error string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	// * Kind of the error, unspecified if the transaction succeeded or the error is not classified
+	ErrorKind DriverErrorKind `protobuf:"varint,5,opt,name=error_kind,json=errorKind,proto3,enum=stroppy.DriverErrorKind" json:"error_kind,omitempty"`
+	// * Results of executed queries in order of execution
+	Queries []*DriverQueryResult `protobuf:"bytes,6,rep,name=queries,proto3" json:"queries,omitempty"`
+	// * Number of retries made before the final attempt
+	Retries uint32 `protobuf:"varint,7,opt,name=retries,proto3" json:"retries,omitempty"`
+	// * Class of the error, unspecified if the transaction succeeded or the error is not classified
+	ErrorClass    DriverErrorClass `protobuf:"varint,8,opt,name=error_class,json=errorClass,proto3,enum=stroppy.DriverErrorClass" json:"error_class,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DriverTransactionResult) Reset() {
+	*x = DriverTransactionResult{}
+	mi := &file_plugins_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DriverTransactionResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DriverTransactionResult) ProtoMessage() {}
+
+func (x *DriverTransactionResult) ProtoReflect() protoreflect.Message {
+	mi := &file_plugins_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DriverTransactionResult.ProtoReflect.Descriptor instead.
+func (*DriverTransactionResult) Descriptor() ([]byte, []int) {
+	return file_plugins_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DriverTransactionResult) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DriverTransactionResult) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *DriverTransactionResult) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *DriverTransactionResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *DriverTransactionResult) GetErrorKind() DriverErrorKind {
+	if x != nil {
+		return x.ErrorKind
+	}
+	return DriverErrorKind_DRIVER_ERROR_KIND_UNSPECIFIED
+}
+
+func (x *DriverTransactionResult) GetQueries() []*DriverQueryResult {
+	if x != nil {
+		return x.Queries
+	}
+	return nil
+}
+
+func (x *DriverTransactionResult) GetRetries() uint32 {
+	if x != nil {
+		return x.Retries
+	}
+	return 0
+}
+
+func (x *DriverTransactionResult) GetErrorClass() DriverErrorClass {
+	if x != nil {
+		return x.ErrorClass
+	}
+	return DriverErrorClass_DRIVER_ERROR_CLASS_UNSPECIFIED
+}
+
+// *
+// DriverQueryResult contains execution statistics of a single query of a transaction.
+type DriverQueryResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// * Name of the query
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// * Query execution time measured by the driver
+	Duration *durationpb.Duration `protobuf:"bytes,2,opt,name=duration,proto3" json:"duration,omitempty"`
+	// * Number of rows inserted, updated or deleted by the query
+	RowsAffected uint64 `protobuf:"varint,3,opt,name=rows_affected,json=rowsAffected,proto3" json:"rows_affected,omitempty"`
+	// * Number of rows returned by the query
+	RowsReturned  uint64 `protobuf:"varint,4,opt,name=rows_returned,json=rowsReturned,proto3" json:"rows_returned,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DriverQueryResult) Reset() {
+	*x = DriverQueryResult{}
+	mi := &file_plugins_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DriverQueryResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DriverQueryResult) ProtoMessage() {}
+
+func (x *DriverQueryResult) ProtoReflect() protoreflect.Message {
+	mi := &file_plugins_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DriverQueryResult.ProtoReflect.Descriptor instead.
+func (*DriverQueryResult) Descriptor() ([]byte, []int) {
+	return file_plugins_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DriverQueryResult) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DriverQueryResult) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+func (x *DriverQueryResult) GetRowsAffected() uint64 {
+	if x != nil {
+		return x.RowsAffected
+	}
+	return 0
+}
+
+func (x *DriverQueryResult) GetRowsReturned() uint64 {
+	if x != nil {
+		return x.RowsReturned
+	}
+	return 0
+}
+
+// *
+// DriverTransactionResultList is a list of transaction results.
+type DriverTransactionResultList struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Results       []*DriverTransactionResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DriverTransactionResultList) Reset() {
+	*x = DriverTransactionResultList{}
+	mi := &file_plugins_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DriverTransactionResultList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DriverTransactionResultList) ProtoMessage() {}
+
+func (x *DriverTransactionResultList) ProtoReflect() protoreflect.Message {
+	mi := &file_plugins_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DriverTransactionResultList.ProtoReflect.Descriptor instead.
+func (*DriverTransactionResultList) Descriptor() ([]byte, []int) {
+	return file_plugins_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DriverTransactionResultList) GetResults() []*DriverTransactionResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// *
+// DriverErrorDetails is attached to gRPC status of errors returned by plugins
+// to let the host tell retryable failures from fatal ones.
+type DriverErrorDetails struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// * Class of the error
+	Class DriverErrorClass `protobuf:"varint,1,opt,name=class,proto3,enum=stroppy.DriverErrorClass" json:"class,omitempty"`
+	// * Kind of the error, if known
+	Kind DriverErrorKind `protobuf:"varint,2,opt,name=kind,proto3,enum=stroppy.DriverErrorKind" json:"kind,omitempty"`
+	// * Original error message
+	Message       string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DriverErrorDetails) Reset() {
+	*x = DriverErrorDetails{}
+	mi := &file_plugins_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DriverErrorDetails) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DriverErrorDetails) ProtoMessage() {}
+
+func (x *DriverErrorDetails) ProtoReflect() protoreflect.Message {
+	mi := &file_plugins_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DriverErrorDetails.ProtoReflect.Descriptor instead.
+func (*DriverErrorDetails) Descriptor() ([]byte, []int) {
+	return file_plugins_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DriverErrorDetails) GetClass() DriverErrorClass {
+	if x != nil {
+		return x.Class
+	}
+	return DriverErrorClass_DRIVER_ERROR_CLASS_UNSPECIFIED
+}
+
+func (x *DriverErrorDetails) GetKind() DriverErrorKind {
+	if x != nil {
+		return x.Kind
+	}
+	return DriverErrorKind_DRIVER_ERROR_KIND_UNSPECIFIED
+}
+
+func (x *DriverErrorDetails) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// *
+// DriverCapabilities describes optional features supported by a driver plugin.
+// Empty lists mean that the driver did not report the feature and the host should not rely on it.
+type DriverCapabilities struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// * Name of the driver, e.g. "postgres"
+	DriverName string `protobuf:"bytes,1,opt,name=driver_name,json=driverName,proto3" json:"driver_name,omitempty"`
+	// * Version of the driver
+	DriverVersion string `protobuf:"bytes,2,opt,name=driver_version,json=driverVersion,proto3" json:"driver_version,omitempty"`
+	// * Plugin protocol version negotiated with the host
+	ProtocolVersion uint32 `protobuf:"varint,3,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"`
+	// * Unit types the driver can build transactions from
+	UnitTypes []DriverCapabilities_UnitType `protobuf:"varint,4,rep,packed,name=unit_types,json=unitTypes,proto3,enum=stroppy.DriverCapabilities_UnitType" json:"unit_types,omitempty"`
+	// * Transaction isolation levels the driver can run
+	IsolationLevels []TxIsolationLevel `protobuf:"varint,5,rep,packed,name=isolation_levels,json=isolationLevels,proto3,enum=stroppy.TxIsolationLevel" json:"isolation_levels,omitempty"`
+	// * Driver supports BuildTransactionsFromUnitStream
+	StreamingBuild bool `protobuf:"varint,6,opt,name=streaming_build,json=streamingBuild,proto3" json:"streaming_build,omitempty"`
+	// * Driver supports running batches of transactions in a single call
+	BatchRun bool `protobuf:"varint,7,opt,name=batch_run,json=batchRun,proto3" json:"batch_run,omitempty"`
+	// * Value types the driver can bind as query params
+	ValueTypes []DriverCapabilities_ValueType `protobuf:"varint,8,rep,packed,name=value_types,json=valueTypes,proto3,enum=stroppy.DriverCapabilities_ValueType" json:"value_types,omitempty"`
+	// * Insert methods the driver supports for insert units
+	InsertMethods []InsertMethod `protobuf:"varint,9,rep,packed,name=insert_methods,json=insertMethods,proto3,enum=stroppy.InsertMethod" json:"insert_methods,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DriverCapabilities) Reset() {
+	*x = DriverCapabilities{}
+	mi := &file_plugins_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DriverCapabilities) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DriverCapabilities) ProtoMessage() {}
+
+func (x *DriverCapabilities) ProtoReflect() protoreflect.Message {
+	mi := &file_plugins_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DriverCapabilities.ProtoReflect.Descriptor instead.
+func (*DriverCapabilities) Descriptor() ([]byte, []int) {
+	return file_plugins_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DriverCapabilities) GetDriverName() string {
+	if x != nil {
+		return x.DriverName
+	}
+	return ""
+}
+
+func (x *DriverCapabilities) GetDriverVersion() string {
+	if x != nil {
+		return x.DriverVersion
+	}
+	return ""
+}
+
+func (x *DriverCapabilities) GetProtocolVersion() uint32 {
+	if x != nil {
+		return x.ProtocolVersion
+	}
+	return 0
+}
+
+func (x *DriverCapabilities) GetUnitTypes() []DriverCapabilities_UnitType {
+	if x != nil {
+		return x.UnitTypes
+	}
+	return nil
+}
+
+func (x *DriverCapabilities) GetIsolationLevels() []TxIsolationLevel {
+	if x != nil {
+		return x.IsolationLevels
+	}
+	return nil
+}
+
+func (x *DriverCapabilities) GetStreamingBuild() bool {
+	if x != nil {
+		return x.StreamingBuild
+	}
+	return false
+}
+
+func (x *DriverCapabilities) GetBatchRun() bool {
+	if x != nil {
+		return x.BatchRun
+	}
+	return false
+}
+
+func (x *DriverCapabilities) GetValueTypes() []DriverCapabilities_ValueType {
+	if x != nil {
+		return x.ValueTypes
+	}
+	return nil
+}
+
+func (x *DriverCapabilities) GetInsertMethods() []InsertMethod {
+	if x != nil {
+		return x.InsertMethods
+	}
+	return nil
+}
+
+// *
+// Metric is a named measurement taken at a point of time.
+type Metric struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// * Name of the metric
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// * Labels distinguishing series of the metric
+	Labels map[string]string `protobuf:"bytes,2,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// * Measured value
+	Value float64 `protobuf:"fixed64,3,opt,name=value,proto3" json:"value,omitempty"`
+	// * Time of the measurement
+	Time          *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=time,proto3" json:"time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Metric) Reset() {
+	*x = Metric{}
+	mi := &file_plugins_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Metric) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Metric) ProtoMessage() {}
+
+func (x *Metric) ProtoReflect() protoreflect.Message {
+	mi := &file_plugins_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Metric.ProtoReflect.Descriptor instead.
+func (*Metric) Descriptor() ([]byte, []int) {
+	return file_plugins_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Metric) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Metric) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *Metric) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *Metric) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+// *
+// Annotation marks a moment of the run, e.g. a checkpoint or failover observed in the database.
+type Annotation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// * Description of the moment
+	Text string `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	// * Labels to filter annotations by
+	Labels map[string]string `protobuf:"bytes,2,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// * Time of the moment
+	Time          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=time,proto3" json:"time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Annotation) Reset() {
+	*x = Annotation{}
+	mi := &file_plugins_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Annotation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Annotation) ProtoMessage() {}
+
+func (x *Annotation) ProtoReflect() protoreflect.Message {
+	mi := &file_plugins_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Annotation.ProtoReflect.Descriptor instead.
+func (*Annotation) Descriptor() ([]byte, []int) {
+	return file_plugins_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Annotation) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Annotation) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *Annotation) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+// *
+// SidecarReport carries metrics and annotations pushed by a sidecar to the host,
+// which merges them into the results and telemetry of the run.
+type SidecarReport struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// * Path of the sidecar which sent the report, set by the host
+	Source        string        `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	Metrics       []*Metric     `protobuf:"bytes,2,rep,name=metrics,proto3" json:"metrics,omitempty"`
+	Annotations   []*Annotation `protobuf:"bytes,3,rep,name=annotations,proto3" json:"annotations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SidecarReport) Reset() {
+	*x = SidecarReport{}
+	mi := &file_plugins_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SidecarReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SidecarReport) ProtoMessage() {}
+
+func (x *SidecarReport) ProtoReflect() protoreflect.Message {
+	mi := &file_plugins_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SidecarReport.ProtoReflect.Descriptor instead.
+func (*SidecarReport) Descriptor() ([]byte, []int) {
+	return file_plugins_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SidecarReport) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *SidecarReport) GetMetrics() []*Metric {
+	if x != nil {
+		return x.Metrics
+	}
+	return nil
+}
+
+func (x *SidecarReport) GetAnnotations() []*Annotation {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+// *
+// ExecutionSummary is the outcome of a finished run, step or unit.
+type ExecutionSummary struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// * Time the execution started
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// * Duration of the execution
+	Duration *durationpb.Duration `protobuf:"bytes,2,opt,name=duration,proto3" json:"duration,omitempty"`
+	// * Number of transactions run
+	Transactions uint64 `protobuf:"varint,3,opt,name=transactions,proto3" json:"transactions,omitempty"`
+	// * Number of failed transactions
+	Errors uint64 `protobuf:"varint,4,opt,name=errors,proto3" json:"errors,omitempty"`
+	// * Error which stopped the execution, empty if it completed
+	Error         string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExecutionSummary) Reset() {
+	*x = ExecutionSummary{}
+	mi := &file_plugins_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecutionSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecutionSummary) ProtoMessage() {}
+
+func (x *ExecutionSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_plugins_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecutionSummary.ProtoReflect.Descriptor instead.
+func (*ExecutionSummary) Descriptor() ([]byte, []int) {
+	return file_plugins_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ExecutionSummary) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *ExecutionSummary) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+func (x *ExecutionSummary) GetTransactions() uint64 {
+	if x != nil {
+		return x.Transactions
+	}
+	return 0
+}
+
+func (x *ExecutionSummary) GetErrors() uint64 {
+	if x != nil {
+		return x.Errors
+	}
+	return 0
+}
+
+func (x *ExecutionSummary) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// *
+// SidecarEvent notifies sidecars about the progress of the run.
+// Events of a step carry its context, run events carry only the global config.
+type SidecarEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// * Time the event happened
+	Time *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	// * Context of the step the event belongs to
+	Context *StepContext `protobuf:"bytes,2,opt,name=context,proto3" json:"context,omitempty"`
+	// Types that are valid to be assigned to Type:
+	//
+	//	*SidecarEvent_RunStart_
+	//	*SidecarEvent_RunEnd_
+	//	*SidecarEvent_UnitStart_
+	//	*SidecarEvent_UnitEnd_
+	//	*SidecarEvent_Metrics
+	//	*SidecarEvent_Error_
+	//	*SidecarEvent_StepResult_
+	Type          isSidecarEvent_Type `protobuf_oneof:"type"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SidecarEvent) Reset() {
+	*x = SidecarEvent{}
+	mi := &file_plugins_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SidecarEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SidecarEvent) ProtoMessage() {}
+
+func (x *SidecarEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_plugins_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SidecarEvent.ProtoReflect.Descriptor instead.
+func (*SidecarEvent) Descriptor() ([]byte, []int) {
+	return file_plugins_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SidecarEvent) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *SidecarEvent) GetContext() *StepContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+func (x *SidecarEvent) GetType() isSidecarEvent_Type {
+	if x != nil {
+		return x.Type
+	}
+	return nil
+}
+
+func (x *SidecarEvent) GetRunStart() *SidecarEvent_RunStart {
+	if x != nil {
+		if x, ok := x.Type.(*SidecarEvent_RunStart_); ok {
+			return x.RunStart
+		}
+	}
+	return nil
+}
+
+func (x *SidecarEvent) GetRunEnd() *SidecarEvent_RunEnd {
+	if x != nil {
+		if x, ok := x.Type.(*SidecarEvent_RunEnd_); ok {
+			return x.RunEnd
+		}
+	}
+	return nil
+}
+
+func (x *SidecarEvent) GetUnitStart() *SidecarEvent_UnitStart {
+	if x != nil {
+		if x, ok := x.Type.(*SidecarEvent_UnitStart_); ok {
+			return x.UnitStart
+		}
+	}
+	return nil
+}
+
+func (x *SidecarEvent) GetUnitEnd() *SidecarEvent_UnitEnd {
+	if x != nil {
+		if x, ok := x.Type.(*SidecarEvent_UnitEnd_); ok {
+			return x.UnitEnd
+		}
+	}
+	return nil
+}
+
+func (x *SidecarEvent) GetMetrics() *SidecarEvent_MetricSnapshot {
+	if x != nil {
+		if x, ok := x.Type.(*SidecarEvent_Metrics); ok {
+			return x.Metrics
+		}
+	}
+	return nil
+}
+
+func (x *SidecarEvent) GetError() *SidecarEvent_Error {
+	if x != nil {
+		if x, ok := x.Type.(*SidecarEvent_Error_); ok {
+			return x.Error
+		}
+	}
+	return nil
+}
+
+func (x *SidecarEvent) GetStepResult() *SidecarEvent_StepResult {
+	if x != nil {
+		if x, ok := x.Type.(*SidecarEvent_StepResult_); ok {
+			return x.StepResult
+		}
+	}
+	return nil
+}
+
+type isSidecarEvent_Type interface {
+	isSidecarEvent_Type()
+}
+
+type SidecarEvent_RunStart_ struct {
+	// * Run is about to start its first step
+	RunStart *SidecarEvent_RunStart `protobuf:"bytes,10,opt,name=run_start,json=runStart,proto3,oneof"`
+}
+
+type SidecarEvent_RunEnd_ struct {
+	// * Run finished
+	RunEnd *SidecarEvent_RunEnd `protobuf:"bytes,11,opt,name=run_end,json=runEnd,proto3,oneof"`
+}
+
+type SidecarEvent_UnitStart_ struct {
+	// * Unit of the step is about to run
+	UnitStart *SidecarEvent_UnitStart `protobuf:"bytes,12,opt,name=unit_start,json=unitStart,proto3,oneof"`
+}
+
+type SidecarEvent_UnitEnd_ struct {
+	// * Unit of the step finished
+	UnitEnd *SidecarEvent_UnitEnd `protobuf:"bytes,13,opt,name=unit_end,json=unitEnd,proto3,oneof"`
+}
+
+type SidecarEvent_Metrics struct {
+	// * Periodic snapshot of client-side metrics
+	Metrics *SidecarEvent_MetricSnapshot `protobuf:"bytes,14,opt,name=metrics,proto3,oneof"`
+}
+
+type SidecarEvent_Error_ struct {
+	// * Transaction or unit failed
+	Error *SidecarEvent_Error `protobuf:"bytes,15,opt,name=error,proto3,oneof"`
+}
+
+type SidecarEvent_StepResult_ struct {
+	// * Final result of the step, sent before OnStepEnd
+	StepResult *SidecarEvent_StepResult `protobuf:"bytes,16,opt,name=step_result,json=stepResult,proto3,oneof"`
+}
+
+func (*SidecarEvent_RunStart_) isSidecarEvent_Type() {}
+
+func (*SidecarEvent_RunEnd_) isSidecarEvent_Type() {}
+
+func (*SidecarEvent_UnitStart_) isSidecarEvent_Type() {}
+
+func (*SidecarEvent_UnitEnd_) isSidecarEvent_Type() {}
+
+func (*SidecarEvent_Metrics) isSidecarEvent_Type() {}
+
+func (*SidecarEvent_Error_) isSidecarEvent_Type() {}
+
+func (*SidecarEvent_StepResult_) isSidecarEvent_Type() {}
+
+// *
+// StepDecision is the answer of a sidecar to the start of a step, it lets sidecars
+// hold steps until the database is ready or abort the run.
+type StepDecision struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// * What the host should do with the step
+	Action StepDecision_Action `protobuf:"varint,1,opt,name=action,proto3,enum=stroppy.StepDecision_Action" json:"action,omitempty"`
+	// * Interval to ask the sidecar again after, used with ACTION_DELAY, 1s if unset
+	PollInterval *durationpb.Duration `protobuf:"bytes,2,opt,name=poll_interval,json=pollInterval,proto3" json:"poll_interval,omitempty"`
+	// * Reason of the delay or abort reported to the user
+	Reason        string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StepDecision) Reset() {
+	*x = StepDecision{}
+	mi := &file_plugins_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StepDecision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StepDecision) ProtoMessage() {}
+
+func (x *StepDecision) ProtoReflect() protoreflect.Message {
+	mi := &file_plugins_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StepDecision.ProtoReflect.Descriptor instead.
+func (*StepDecision) Descriptor() ([]byte, []int) {
+	return file_plugins_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *StepDecision) GetAction() StepDecision_Action {
+	if x != nil {
+		return x.Action
+	}
+	return StepDecision_ACTION_PROCEED
+}
+
+func (x *StepDecision) GetPollInterval() *durationpb.Duration {
+	if x != nil {
+		return x.PollInterval
+	}
+	return nil
+}
+
+func (x *StepDecision) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type SidecarEvent_RunStart struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SidecarEvent_RunStart) Reset() {
+	*x = SidecarEvent_RunStart{}
+	mi := &file_plugins_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SidecarEvent_RunStart) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SidecarEvent_RunStart) ProtoMessage() {}
+
+func (x *SidecarEvent_RunStart) ProtoReflect() protoreflect.Message {
+	mi := &file_plugins_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SidecarEvent_RunStart.ProtoReflect.Descriptor instead.
+func (*SidecarEvent_RunStart) Descriptor() ([]byte, []int) {
+	return file_plugins_proto_rawDescGZIP(), []int{15, 0}
+}
+
+type SidecarEvent_RunEnd struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Summary       *ExecutionSummary      `protobuf:"bytes,1,opt,name=summary,proto3" json:"summary,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SidecarEvent_RunEnd) Reset() {
+	*x = SidecarEvent_RunEnd{}
+	mi := &file_plugins_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SidecarEvent_RunEnd) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SidecarEvent_RunEnd) ProtoMessage() {}
+
+func (x *SidecarEvent_RunEnd) ProtoReflect() protoreflect.Message {
+	mi := &file_plugins_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SidecarEvent_RunEnd.ProtoReflect.Descriptor instead.
+func (*SidecarEvent_RunEnd) Descriptor() ([]byte, []int) {
+	return file_plugins_proto_rawDescGZIP(), []int{15, 1}
+}
+
+func (x *SidecarEvent_RunEnd) GetSummary() *ExecutionSummary {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+type SidecarEvent_UnitStart struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Unit          *StepUnitDescriptor    `protobuf:"bytes,1,opt,name=unit,proto3" json:"unit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SidecarEvent_UnitStart) Reset() {
+	*x = SidecarEvent_UnitStart{}
+	mi := &file_plugins_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SidecarEvent_UnitStart) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SidecarEvent_UnitStart) ProtoMessage() {}
+
+func (x *SidecarEvent_UnitStart) ProtoReflect() protoreflect.Message {
+	mi := &file_plugins_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SidecarEvent_UnitStart.ProtoReflect.Descriptor instead.
+func (*SidecarEvent_UnitStart) Descriptor() ([]byte, []int) {
+	return file_plugins_proto_rawDescGZIP(), []int{15, 2}
+}
+
+func (x *SidecarEvent_UnitStart) GetUnit() *StepUnitDescriptor {
+	if x != nil {
+		return x.Unit
+	}
+	return nil
+}
+
+type SidecarEvent_UnitEnd struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Unit          *StepUnitDescriptor    `protobuf:"bytes,1,opt,name=unit,proto3" json:"unit,omitempty"`
+	Summary       *ExecutionSummary      `protobuf:"bytes,2,opt,name=summary,proto3" json:"summary,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SidecarEvent_UnitEnd) Reset() {
+	*x = SidecarEvent_UnitEnd{}
+	mi := &file_plugins_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SidecarEvent_UnitEnd) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SidecarEvent_UnitEnd) ProtoMessage() {}
+
+func (x *SidecarEvent_UnitEnd) ProtoReflect() protoreflect.Message {
+	mi := &file_plugins_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SidecarEvent_UnitEnd.ProtoReflect.Descriptor instead.
+func (*SidecarEvent_UnitEnd) Descriptor() ([]byte, []int) {
+	return file_plugins_proto_rawDescGZIP(), []int{15, 3}
+}
+
+func (x *SidecarEvent_UnitEnd) GetUnit() *StepUnitDescriptor {
+	if x != nil {
+		return x.Unit
+	}
+	return nil
+}
+
+func (x *SidecarEvent_UnitEnd) GetSummary() *ExecutionSummary {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+type SidecarEvent_MetricSnapshot struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Metrics       []*Metric              `protobuf:"bytes,1,rep,name=metrics,proto3" json:"metrics,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SidecarEvent_MetricSnapshot) Reset() {
+	*x = SidecarEvent_MetricSnapshot{}
+	mi := &file_plugins_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SidecarEvent_MetricSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SidecarEvent_MetricSnapshot) ProtoMessage() {}
+
+func (x *SidecarEvent_MetricSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_plugins_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SidecarEvent_MetricSnapshot.ProtoReflect.Descriptor instead.
+func (*SidecarEvent_MetricSnapshot) Descriptor() ([]byte, []int) {
+	return file_plugins_proto_rawDescGZIP(), []int{15, 4}
+}
+
+func (x *SidecarEvent_MetricSnapshot) GetMetrics() []*Metric {
+	if x != nil {
+		return x.Metrics
+	}
+	return nil
+}
+
+type SidecarEvent_Error struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// * Unit which failed or which transaction failed
+	Unit          *StepUnitDescriptor `protobuf:"bytes,1,opt,name=unit,proto3" json:"unit,omitempty"`
+	Message       string              `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Class         DriverErrorClass    `protobuf:"varint,3,opt,name=class,proto3,enum=stroppy.DriverErrorClass" json:"class,omitempty"`
+	Kind          DriverErrorKind     `protobuf:"varint,4,opt,name=kind,proto3,enum=stroppy.DriverErrorKind" json:"kind,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SidecarEvent_Error) Reset() {
+	*x = SidecarEvent_Error{}
+	mi := &file_plugins_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SidecarEvent_Error) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SidecarEvent_Error) ProtoMessage() {}
+
+func (x *SidecarEvent_Error) ProtoReflect() protoreflect.Message {
+	mi := &file_plugins_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SidecarEvent_Error.ProtoReflect.Descriptor instead.
+func (*SidecarEvent_Error) Descriptor() ([]byte, []int) {
+	return file_plugins_proto_rawDescGZIP(), []int{15, 5}
+}
+
+func (x *SidecarEvent_Error) GetUnit() *StepUnitDescriptor {
+	if x != nil {
+		return x.Unit
+	}
+	return nil
+}
+
+func (x *SidecarEvent_Error) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SidecarEvent_Error) GetClass() DriverErrorClass {
+	if x != nil {
+		return x.Class
+	}
+	return DriverErrorClass_DRIVER_ERROR_CLASS_UNSPECIFIED
+}
+
+func (x *SidecarEvent_Error) GetKind() DriverErrorKind {
+	if x != nil {
+		return x.Kind
+	}
+	return DriverErrorKind_DRIVER_ERROR_KIND_UNSPECIFIED
+}
+
+type SidecarEvent_StepResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Summary       *ExecutionSummary      `protobuf:"bytes,1,opt,name=summary,proto3" json:"summary,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SidecarEvent_StepResult) Reset() {
+	*x = SidecarEvent_StepResult{}
+	mi := &file_plugins_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SidecarEvent_StepResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SidecarEvent_StepResult) ProtoMessage() {}
+
+func (x *SidecarEvent_StepResult) ProtoReflect() protoreflect.Message {
+	mi := &file_plugins_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SidecarEvent_StepResult.ProtoReflect.Descriptor instead.
+func (*SidecarEvent_StepResult) Descriptor() ([]byte, []int) {
+	return file_plugins_proto_rawDescGZIP(), []int{15, 6}
+}
+
+func (x *SidecarEvent_StepResult) GetSummary() *ExecutionSummary {
+	if x != nil {
+		return x.Summary
+	}
+	return nil
+}
+
+var File_plugins_proto protoreflect.FileDescriptor
+
+const file_plugins_proto_rawDesc = "" +
+	"\n" +
+	"\rplugins.proto\x12\astroppy\x1a\fcommon.proto\x1a\fconfig.proto\x1a\x10descriptor.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"s\n" +
+	"\x10UnitBuildContext\x12.\n" +
+	"\acontext\x18\x01 \x01(\v2\x14.stroppy.StepContextR\acontext\x12/\n" +
+	"\x04unit\x18\x02 \x01(\v2\x1b.stroppy.StepUnitDescriptorR\x04unit\"c\n" +
+	"\vDriverQuery\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\arequest\x18\x02 \x01(\tR\arequest\x12&\n" +
+	"\x06params\x18\x03 \x03(\v2\x0e.stroppy.ValueR\x06params\"\xc3\x01\n" +
+	"\x11DriverTransaction\x12.\n" +
+	"\aqueries\x18\x01 \x03(\v2\x14.stroppy.DriverQueryR\aqueries\x12B\n" +
+	"\x0fisolation_level\x18\x02 \x01(\x0e2\x19.stroppy.TxIsolationLevelR\x0eisolationLevel\x12:\n" +
+	"\vbulk_insert\x18\x03 \x01(\v2\x19.stroppy.DriverBulkInsertR\n" +
+	"bulkInsert\"\xef\x01\n" +
+	"\x10DriverBulkInsert\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"table_name\x18\x02 \x01(\tR\ttableName\x12\x18\n" +
+	"\acolumns\x18\x03 \x03(\tR\acolumns\x12'\n" +
+	"\x04rows\x18\x04 \x03(\v2\x13.stroppy.Value.ListR\x04rows\x12-\n" +
+	"\x06method\x18\x05 \x01(\x0e2\x15.stroppy.InsertMethodR\x06method\x126\n" +
+	"\vdb_specific\x18\x06 \x01(\v2\x15.stroppy.Value.StructR\n" +
+	"dbSpecific\"W\n" +
+	"\x15DriverTransactionList\x12>\n" +
+	"\ftransactions\x18\x01 \x03(\v2\x1a.stroppy.DriverTransactionR\ftransactions\"\x83\x01\n" +
+	"\x13TransactionLogEntry\x12.\n" +
+	"\x04time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x12<\n" +
+	"\vtransaction\x18\x02 \x01(\v2\x1a.stroppy.DriverTransactionR\vtransaction\"\xf6\x02\n" +
+	"\x17DriverTransactionResult\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x129\n" +
+	"\n" +
+	"start_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\x127\n" +
+	"\n" +
+	"error_kind\x18\x05 \x01(\x0e2\x18.stroppy.DriverErrorKindR\terrorKind\x124\n" +
+	"\aqueries\x18\x06 \x03(\v2\x1a.stroppy.DriverQueryResultR\aqueries\x12\x18\n" +
+	"\aretries\x18\a \x01(\rR\aretries\x12:\n" +
+	"\verror_class\x18\b \x01(\x0e2\x19.stroppy.DriverErrorClassR\n" +
+	"errorClass\"\xa8\x01\n" +
+	"\x11DriverQueryResult\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x125\n" +
+	"\bduration\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\bduration\x12#\n" +
+	"\rrows_affected\x18\x03 \x01(\x04R\frowsAffected\x12#\n" +
+	"\rrows_returned\x18\x04 \x01(\x04R\frowsReturned\"Y\n" +
+	"\x1bDriverTransactionResultList\x12:\n" +
+	"\aresults\x18\x01 \x03(\v2 .stroppy.DriverTransactionResultR\aresults\"\x8d\x01\n" +
+	"\x12DriverErrorDetails\x12/\n" +
+	"\x05class\x18\x01 \x01(\x0e2\x19.stroppy.DriverErrorClassR\x05class\x12,\n" +
+	"\x04kind\x18\x02 \x01(\x0e2\x18.stroppy.DriverErrorKindR\x04kind\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"\xcc\a\n" +
+	"\x12DriverCapabilities\x12\x1f\n" +
+	"\vdriver_name\x18\x01 \x01(\tR\n" +
+	"driverName\x12%\n" +
+	"\x0edriver_version\x18\x02 \x01(\tR\rdriverVersion\x12)\n" +
+	"\x10protocol_version\x18\x03 \x01(\rR\x0fprotocolVersion\x12C\n" +
+	"\n" +
+	"unit_types\x18\x04 \x03(\x0e2$.stroppy.DriverCapabilities.UnitTypeR\tunitTypes\x12D\n" +
+	"\x10isolation_levels\x18\x05 \x03(\x0e2\x19.stroppy.TxIsolationLevelR\x0fisolationLevels\x12'\n" +
+	"\x0fstreaming_build\x18\x06 \x01(\bR\x0estreamingBuild\x12\x1b\n" +
+	"\tbatch_run\x18\a \x01(\bR\bbatchRun\x12F\n" +
+	"\vvalue_types\x18\b \x03(\x0e2%.stroppy.DriverCapabilities.ValueTypeR\n" +
+	"valueTypes\x12<\n" +
+	"\x0einsert_methods\x18\t \x03(\x0e2\x15.stroppy.InsertMethodR\rinsertMethods\"\x87\x01\n" +
+	"\bUnitType\x12\x19\n" +
+	"\x15UNIT_TYPE_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16UNIT_TYPE_CREATE_TABLE\x10\x01\x12\x13\n" +
+	"\x0fUNIT_TYPE_QUERY\x10\x02\x12\x19\n" +
+	"\x15UNIT_TYPE_TRANSACTION\x10\x03\x12\x14\n" +
+	"\x10UNIT_TYPE_INSERT\x10\x04\"\xe1\x02\n" +
+	"\tValueType\x12\x1a\n" +
+	"\x16VALUE_TYPE_UNSPECIFIED\x10\x00\x12\x13\n" +
+	"\x0fVALUE_TYPE_NULL\x10\x01\x12\x14\n" +
+	"\x10VALUE_TYPE_INT32\x10\x02\x12\x15\n" +
+	"\x11VALUE_TYPE_UINT32\x10\x03\x12\x14\n" +
+	"\x10VALUE_TYPE_INT64\x10\x04\x12\x15\n" +
+	"\x11VALUE_TYPE_UINT64\x10\x05\x12\x14\n" +
+	"\x10VALUE_TYPE_FLOAT\x10\x06\x12\x15\n" +
+	"\x11VALUE_TYPE_DOUBLE\x10\a\x12\x15\n" +
+	"\x11VALUE_TYPE_STRING\x10\b\x12\x13\n" +
+	"\x0fVALUE_TYPE_BOOL\x10\t\x12\x16\n" +
+	"\x12VALUE_TYPE_DECIMAL\x10\n" +
+	"\x12\x13\n" +
+	"\x0fVALUE_TYPE_UUID\x10\v\x12\x17\n" +
+	"\x13VALUE_TYPE_DATETIME\x10\f\x12\x15\n" +
+	"\x11VALUE_TYPE_STRUCT\x10\r\x12\x13\n" +
+	"\x0fVALUE_TYPE_LIST\x10\x0e\"\xd2\x01\n" +
+	"\x06Metric\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x123\n" +
+	"\x06labels\x18\x02 \x03(\v2\x1b.stroppy.Metric.LabelsEntryR\x06labels\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\x01R\x05value\x12.\n" +
+	"\x04time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xc4\x01\n" +
+	"\n" +
+	"Annotation\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x127\n" +
+	"\x06labels\x18\x02 \x03(\v2\x1f.stroppy.Annotation.LabelsEntryR\x06labels\x12.\n" +
+	"\x04time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x89\x01\n" +
+	"\rSidecarReport\x12\x16\n" +
+	"\x06source\x18\x01 \x01(\tR\x06source\x12)\n" +
+	"\ametrics\x18\x02 \x03(\v2\x0f.stroppy.MetricR\ametrics\x125\n" +
+	"\vannotations\x18\x03 \x03(\v2\x13.stroppy.AnnotationR\vannotations\"\xd6\x01\n" +
+	"\x10ExecutionSummary\x129\n" +
+	"\n" +
+	"start_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bduration\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\bduration\x12\"\n" +
+	"\ftransactions\x18\x03 \x01(\x04R\ftransactions\x12\x16\n" +
+	"\x06errors\x18\x04 \x01(\x04R\x06errors\x12\x14\n" +
+	"\x05error\x18\x05 \x01(\tR\x05error\"\xd6\b\n" +
+	"\fSidecarEvent\x12.\n" +
+	"\x04time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x12.\n" +
+	"\acontext\x18\x02 \x01(\v2\x14.stroppy.StepContextR\acontext\x12=\n" +
+	"\trun_start\x18\n" +
+	" \x01(\v2\x1e.stroppy.SidecarEvent.RunStartH\x00R\brunStart\x127\n" +
+	"\arun_end\x18\v \x01(\v2\x1c.stroppy.SidecarEvent.RunEndH\x00R\x06runEnd\x12@\n" +
+	"\n" +
+	"unit_start\x18\f \x01(\v2\x1f.stroppy.SidecarEvent.UnitStartH\x00R\tunitStart\x12:\n" +
+	"\bunit_end\x18\r \x01(\v2\x1d.stroppy.SidecarEvent.UnitEndH\x00R\aunitEnd\x12@\n" +
+	"\ametrics\x18\x0e \x01(\v2$.stroppy.SidecarEvent.MetricSnapshotH\x00R\ametrics\x123\n" +
+	"\x05error\x18\x0f \x01(\v2\x1b.stroppy.SidecarEvent.ErrorH\x00R\x05error\x12C\n" +
+	"\vstep_result\x18\x10 \x01(\v2 .stroppy.SidecarEvent.StepResultH\x00R\n" +
+	"stepResult\x1a\n" +
+	"\n" +
+	"\bRunStart\x1a=\n" +
+	"\x06RunEnd\x123\n" +
+	"\asummary\x18\x01 \x01(\v2\x19.stroppy.ExecutionSummaryR\asummary\x1a<\n" +
+	"\tUnitStart\x12/\n" +
+	"\x04unit\x18\x01 \x01(\v2\x1b.stroppy.StepUnitDescriptorR\x04unit\x1ao\n" +
+	"\aUnitEnd\x12/\n" +
+	"\x04unit\x18\x01 \x01(\v2\x1b.stroppy.StepUnitDescriptorR\x04unit\x123\n" +
+	"\asummary\x18\x02 \x01(\v2\x19.stroppy.ExecutionSummaryR\asummary\x1a;\n" +
+	"\x0eMetricSnapshot\x12)\n" +
+	"\ametrics\x18\x01 \x03(\v2\x0f.stroppy.MetricR\ametrics\x1a\xb1\x01\n" +
+	"\x05Error\x12/\n" +
+	"\x04unit\x18\x01 \x01(\v2\x1b.stroppy.StepUnitDescriptorR\x04unit\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12/\n" +
+	"\x05class\x18\x03 \x01(\x0e2\x19.stroppy.DriverErrorClassR\x05class\x12,\n" +
+	"\x04kind\x18\x04 \x01(\x0e2\x18.stroppy.DriverErrorKindR\x04kind\x1aA\n" +
+	"\n" +
+	"StepResult\x123\n" +
+	"\asummary\x18\x01 \x01(\v2\x19.stroppy.ExecutionSummaryR\asummaryB\x06\n" +
+	"\x04type\"\xde\x01\n" +
+	"\fStepDecision\x124\n" +
+	"\x06action\x18\x01 \x01(\x0e2\x1c.stroppy.StepDecision.ActionR\x06action\x12>\n" +
+	"\rpoll_interval\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\fpollInterval\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"@\n" +
+	"\x06Action\x12\x12\n" +
+	"\x0eACTION_PROCEED\x10\x00\x12\x10\n" +
+	"\fACTION_DELAY\x10\x01\x12\x10\n" +
+	"\fACTION_ABORT\x10\x02*\xf3\x01\n" +
+	"\x0fDriverErrorKind\x12!\n" +
+	"\x1dDRIVER_ERROR_KIND_UNSPECIFIED\x10\x00\x12+\n" +
+	"'DRIVER_ERROR_KIND_SERIALIZATION_FAILURE\x10\x01\x12\x1e\n" +
+	"\x1aDRIVER_ERROR_KIND_DEADLOCK\x10\x02\x12*\n" +
+	"&DRIVER_ERROR_KIND_CONSTRAINT_VIOLATION\x10\x03\x12\x1d\n" +
+	"\x19DRIVER_ERROR_KIND_TIMEOUT\x10\x04\x12%\n" +
+	"!DRIVER_ERROR_KIND_CONNECTION_LOST\x10\x052\x84\x05\n" +
+	"\fDriverPlugin\x12:\n" +
+	"\n" +
+	"Initialize\x12\x14.stroppy.StepContext\x1a\x16.google.protobuf.Empty\x12V\n" +
+	"\x19BuildTransactionsFromUnit\x12\x19.stroppy.UnitBuildContext\x1a\x1e.stroppy.DriverTransactionList\x12Z\n" +
+	"\x1fBuildTransactionsFromUnitStream\x12\x19.stroppy.UnitBuildContext\x1a\x1a.stroppy.DriverTransaction0\x01\x12N\n" +
+	"\x0eRunTransaction\x12\x1a.stroppy.DriverTransaction\x1a .stroppy.DriverTransactionResult\x12:\n" +
+	"\bTeardown\x12\x16.google.protobuf.Empty\x1a\x16.google.protobuf.Empty\x12F\n" +
+	"\x0fGetCapabilities\x12\x16.google.protobuf.Empty\x1a\x1b.stroppy.DriverCapabilities\x12S\n" +
+	"\x0fRunTransactions\x12\x1a.stroppy.DriverTransaction\x1a .stroppy.DriverTransactionResult(\x010\x01\x12[\n" +
+	"\x13RunTransactionBatch\x12\x1e.stroppy.DriverTransactionList\x1a$.stroppy.DriverTransactionResultList2\xf5\x02\n" +
+	"\rSidecarPlugin\x12:\n" +
+	"\n" +
+	"Initialize\x12\x14.stroppy.StepContext\x1a\x16.google.protobuf.Empty\x12:\n" +
+	"\vOnStepStart\x12\x14.stroppy.StepContext\x1a\x15.stroppy.StepDecision\x129\n" +
+	"\tOnStepEnd\x12\x14.stroppy.StepContext\x1a\x16.google.protobuf.Empty\x128\n" +
+	"\aOnEvent\x12\x15.stroppy.SidecarEvent\x1a\x16.google.protobuf.Empty\x12;\n" +
+	"\aReports\x12\x16.google.protobuf.Empty\x1a\x16.stroppy.SidecarReport0\x01\x12:\n" +
+	"\bTeardown\x12\x16.google.protobuf.Empty\x1a\x16.google.protobuf.EmptyB.Z,github.com/stroppy-io/stroppy-core/pkg/protob\x06proto3"
+
+var (
+	file_plugins_proto_rawDescOnce sync.Once
+	file_plugins_proto_rawDescData []byte
+)
+
+func file_plugins_proto_rawDescGZIP() []byte {
+	file_plugins_proto_rawDescOnce.Do(func() {
+		file_plugins_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_plugins_proto_rawDesc), len(file_plugins_proto_rawDesc)))
+	})
+	return file_plugins_proto_rawDescData
+}
+
+var file_plugins_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_plugins_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
+var file_plugins_proto_goTypes = []any{
+	(DriverErrorKind)(0),                // 0: stroppy.DriverErrorKind
+	(DriverCapabilities_UnitType)(0),    // 1: stroppy.DriverCapabilities.UnitType
+	(DriverCapabilities_ValueType)(0),   // 2: stroppy.DriverCapabilities.ValueType
+	(StepDecision_Action)(0),            // 3: stroppy.StepDecision.Action
+	(*UnitBuildContext)(nil),            // 4: stroppy.UnitBuildContext
+	(*DriverQuery)(nil),                 // 5: stroppy.DriverQuery
+	(*DriverTransaction)(nil),           // 6: stroppy.DriverTransaction
+	(*DriverBulkInsert)(nil),            // 7: stroppy.DriverBulkInsert
+	(*DriverTransactionList)(nil),       // 8: stroppy.DriverTransactionList
+	(*TransactionLogEntry)(nil),         // 9: stroppy.TransactionLogEntry
+	(*DriverTransactionResult)(nil),     // 10: stroppy.DriverTransactionResult
+	(*DriverQueryResult)(nil),           // 11: stroppy.DriverQueryResult
+	(*DriverTransactionResultList)(nil), // 12: stroppy.DriverTransactionResultList
+	(*DriverErrorDetails)(nil),          // 13: stroppy.DriverErrorDetails
+	(*DriverCapabilities)(nil),          // 14: stroppy.DriverCapabilities
+	(*Metric)(nil),                      // 15: stroppy.Metric
+	(*Annotation)(nil),                  // 16: stroppy.Annotation
+	(*SidecarReport)(nil),               // 17: stroppy.SidecarReport
+	(*ExecutionSummary)(nil),            // 18: stroppy.ExecutionSummary
+	(*SidecarEvent)(nil),                // 19: stroppy.SidecarEvent
+	(*StepDecision)(nil),                // 20: stroppy.StepDecision
+	nil,                                 // 21: stroppy.Metric.LabelsEntry
+	nil,                                 // 22: stroppy.Annotation.LabelsEntry
+	(*SidecarEvent_RunStart)(nil),       // 23: stroppy.SidecarEvent.RunStart
+	(*SidecarEvent_RunEnd)(nil),         // 24: stroppy.SidecarEvent.RunEnd
+	(*SidecarEvent_UnitStart)(nil),      // 25: stroppy.SidecarEvent.UnitStart
+	(*SidecarEvent_UnitEnd)(nil),        // 26: stroppy.SidecarEvent.UnitEnd
+	(*SidecarEvent_MetricSnapshot)(nil), // 27: stroppy.SidecarEvent.MetricSnapshot
+	(*SidecarEvent_Error)(nil),          // 28: stroppy.SidecarEvent.Error
+	(*SidecarEvent_StepResult)(nil),     // 29: stroppy.SidecarEvent.StepResult
+	(*StepContext)(nil),                 // 30: stroppy.StepContext
+	(*StepUnitDescriptor)(nil),          // 31: stroppy.StepUnitDescriptor
+	(*Value)(nil),                       // 32: stroppy.Value
+	(TxIsolationLevel)(0),               // 33: stroppy.TxIsolationLevel
+	(*Value_List)(nil),                  // 34: stroppy.Value.List
+	(InsertMethod)(0),                   // 35: stroppy.InsertMethod
+	(*Value_Struct)(nil),                // 36: stroppy.Value.Struct
+	(*timestamppb.Timestamp)(nil),       // 37: google.protobuf.Timestamp
+	(DriverErrorClass)(0),               // 38: stroppy.DriverErrorClass
+	(*durationpb.Duration)(nil),         // 39: google.protobuf.Duration
+	(*emptypb.Empty)(nil),               // 40: google.protobuf.Empty
+}
+var file_plugins_proto_depIdxs = []int32{
+	30, // 0: stroppy.UnitBuildContext.context:type_name -> stroppy.StepContext
+	31, // 1: stroppy.UnitBuildContext.unit:type_name -> stroppy.StepUnitDescriptor
+	32, // 2: stroppy.DriverQuery.params:type_name -> stroppy.Value
+	5,  // 3: stroppy.DriverTransaction.queries:type_name -> stroppy.DriverQuery
+	33, // 4: stroppy.DriverTransaction.isolation_level:type_name -> stroppy.TxIsolationLevel
+	7,  // 5: stroppy.DriverTransaction.bulk_insert:type_name -> stroppy.DriverBulkInsert
+	34, // 6: stroppy.DriverBulkInsert.rows:type_name -> stroppy.Value.List
+	35, // 7: stroppy.DriverBulkInsert.method:type_name -> stroppy.InsertMethod
+	36, // 8: stroppy.DriverBulkInsert.db_specific:type_name -> stroppy.Value.Struct
+	6,  // 9: stroppy.DriverTransactionList.transactions:type_name -> stroppy.DriverTransaction
+	37, // 10: stroppy.TransactionLogEntry.time:type_name -> google.protobuf.Timestamp
+	6,  // 11: stroppy.TransactionLogEntry.transaction:type_name -> stroppy.DriverTransaction
+	37, // 12: stroppy.DriverTransactionResult.start_time:type_name -> google.protobuf.Timestamp
+	37, // 13: stroppy.DriverTransactionResult.end_time:type_name -> google.protobuf.Timestamp
+	0,  // 14: stroppy.DriverTransactionResult.error_kind:type_name -> stroppy.DriverErrorKind
+	11, // 15: stroppy.DriverTransactionResult.queries:type_name -> stroppy.DriverQueryResult
+	38, // 16: stroppy.DriverTransactionResult.error_class:type_name -> stroppy.DriverErrorClass
+	39, // 17: stroppy.DriverQueryResult.duration:type_name -> google.protobuf.Duration
+	10, // 18: stroppy.DriverTransactionResultList.results:type_name -> stroppy.DriverTransactionResult
+	38, // 19: stroppy.DriverErrorDetails.class:type_name -> stroppy.DriverErrorClass
+	0,  // 20: stroppy.DriverErrorDetails.kind:type_name -> stroppy.DriverErrorKind
+	1,  // 21: stroppy.DriverCapabilities.unit_types:type_name -> stroppy.DriverCapabilities.UnitType
+	33, // 22: stroppy.DriverCapabilities.isolation_levels:type_name -> stroppy.TxIsolationLevel
+	2,  // 23: stroppy.DriverCapabilities.value_types:type_name -> stroppy.DriverCapabilities.ValueType
+	35, // 24: stroppy.DriverCapabilities.insert_methods:type_name -> stroppy.InsertMethod
+	21, // 25: stroppy.Metric.labels:type_name -> stroppy.Metric.LabelsEntry
+	37, // 26: stroppy.Metric.time:type_name -> google.protobuf.Timestamp
+	22, // 27: stroppy.Annotation.labels:type_name -> stroppy.Annotation.LabelsEntry
+	37, // 28: stroppy.Annotation.time:type_name -> google.protobuf.Timestamp
+	15, // 29: stroppy.SidecarReport.metrics:type_name -> stroppy.Metric
+	16, // 30: stroppy.SidecarReport.annotations:type_name -> stroppy.Annotation
+	37, // 31: stroppy.ExecutionSummary.start_time:type_name -> google.protobuf.Timestamp
+	39, // 32: stroppy.ExecutionSummary.duration:type_name -> google.protobuf.Duration
+	37, // 33: stroppy.SidecarEvent.time:type_name -> google.protobuf.Timestamp
+	30, // 34: stroppy.SidecarEvent.context:type_name -> stroppy.StepContext
+	23, // 35: stroppy.SidecarEvent.run_start:type_name -> stroppy.SidecarEvent.RunStart
+	24, // 36: stroppy.SidecarEvent.run_end:type_name -> stroppy.SidecarEvent.RunEnd
+	25, // 37: stroppy.SidecarEvent.unit_start:type_name -> stroppy.SidecarEvent.UnitStart
+	26, // 38: stroppy.SidecarEvent.unit_end:type_name -> stroppy.SidecarEvent.UnitEnd
+	27, // 39: stroppy.SidecarEvent.metrics:type_name -> stroppy.SidecarEvent.MetricSnapshot
+	28, // 40: stroppy.SidecarEvent.error:type_name -> stroppy.SidecarEvent.Error
+	29, // 41: stroppy.SidecarEvent.step_result:type_name -> stroppy.SidecarEvent.StepResult
+	3,  // 42: stroppy.StepDecision.action:type_name -> stroppy.StepDecision.Action
+	39, // 43: stroppy.StepDecision.poll_interval:type_name -> google.protobuf.Duration
+	18, // 44: stroppy.SidecarEvent.RunEnd.summary:type_name -> stroppy.ExecutionSummary
+	31, // 45: stroppy.SidecarEvent.UnitStart.unit:type_name -> stroppy.StepUnitDescriptor
+	31, // 46: stroppy.SidecarEvent.UnitEnd.unit:type_name -> stroppy.StepUnitDescriptor
+	18, // 47: stroppy.SidecarEvent.UnitEnd.summary:type_name -> stroppy.ExecutionSummary
+	15, // 48: stroppy.SidecarEvent.MetricSnapshot.metrics:type_name -> stroppy.Metric
+	31, // 49: stroppy.SidecarEvent.Error.unit:type_name -> stroppy.StepUnitDescriptor
+	38, // 50: stroppy.SidecarEvent.Error.class:type_name -> stroppy.DriverErrorClass
+	0,  // 51: stroppy.SidecarEvent.Error.kind:type_name -> stroppy.DriverErrorKind
+	18, // 52: stroppy.SidecarEvent.StepResult.summary:type_name -> stroppy.ExecutionSummary
+	30, // 53: stroppy.DriverPlugin.Initialize:input_type -> stroppy.StepContext
+	4,  // 54: stroppy.DriverPlugin.BuildTransactionsFromUnit:input_type -> stroppy.UnitBuildContext
+	4,  // 55: stroppy.DriverPlugin.BuildTransactionsFromUnitStream:input_type -> stroppy.UnitBuildContext
+	6,  // 56: stroppy.DriverPlugin.RunTransaction:input_type -> stroppy.DriverTransaction
+	40, // 57: stroppy.DriverPlugin.Teardown:input_type -> google.protobuf.Empty
+	40, // 58: stroppy.DriverPlugin.GetCapabilities:input_type -> google.protobuf.Empty
+	6,  // 59: stroppy.DriverPlugin.RunTransactions:input_type -> stroppy.DriverTransaction
+	8,  // 60: stroppy.DriverPlugin.RunTransactionBatch:input_type -> stroppy.DriverTransactionList
+	30, // 61: stroppy.SidecarPlugin.Initialize:input_type -> stroppy.StepContext
+	30, // 62: stroppy.SidecarPlugin.OnStepStart:input_type -> stroppy.StepContext
+	30, // 63: stroppy.SidecarPlugin.OnStepEnd:input_type -> stroppy.StepContext
+	19, // 64: stroppy.SidecarPlugin.OnEvent:input_type -> stroppy.SidecarEvent
+	40, // 65: stroppy.SidecarPlugin.Reports:input_type -> google.protobuf.Empty
+	40, // 66: stroppy.SidecarPlugin.Teardown:input_type -> google.protobuf.Empty
+	40, // 67: stroppy.DriverPlugin.Initialize:output_type -> google.protobuf.Empty
+	8,  // 68: stroppy.DriverPlugin.BuildTransactionsFromUnit:output_type -> stroppy.DriverTransactionList
+	6,  // 69: stroppy.DriverPlugin.BuildTransactionsFromUnitStream:output_type -> stroppy.DriverTransaction
+	10, // 70: stroppy.DriverPlugin.RunTransaction:output_type -> stroppy.DriverTransactionResult
+	40, // 71: stroppy.DriverPlugin.Teardown:output_type -> google.protobuf.Empty
+	14, // 72: stroppy.DriverPlugin.GetCapabilities:output_type -> stroppy.DriverCapabilities
+	10, // 73: stroppy.DriverPlugin.RunTransactions:output_type -> stroppy.DriverTransactionResult
+	12, // 74: stroppy.DriverPlugin.RunTransactionBatch:output_type -> stroppy.DriverTransactionResultList
+	40, // 75: stroppy.SidecarPlugin.Initialize:output_type -> google.protobuf.Empty
+	20, // 76: stroppy.SidecarPlugin.OnStepStart:output_type -> stroppy.StepDecision
+	40, // 77: stroppy.SidecarPlugin.OnStepEnd:output_type -> google.protobuf.Empty
+	40, // 78: stroppy.SidecarPlugin.OnEvent:output_type -> google.protobuf.Empty
+	17, // 79: stroppy.SidecarPlugin.Reports:output_type -> stroppy.SidecarReport
+	40, // 80: stroppy.SidecarPlugin.Teardown:output_type -> google.protobuf.Empty
+	67, // [67:81] is the sub-list for method output_type
+	53, // [53:67] is the sub-list for method input_type
+	53, // [53:53] is the sub-list for extension type_name
+	53, // [53:53] is the sub-list for extension extendee
+	0,  // [0:53] is the sub-list for field type_name
+}
+
+func init() { file_plugins_proto_init() }
+func file_plugins_proto_init() {
+	if File_plugins_proto != nil {
+		return
+	}
+	file_common_proto_init()
+	file_config_proto_init()
+	file_descriptor_proto_init()
+	file_plugins_proto_msgTypes[15].OneofWrappers = []any{
+		(*SidecarEvent_RunStart_)(nil),
+		(*SidecarEvent_RunEnd_)(nil),
+		(*SidecarEvent_UnitStart_)(nil),
+		(*SidecarEvent_UnitEnd_)(nil),
+		(*SidecarEvent_Metrics)(nil),
+		(*SidecarEvent_Error_)(nil),
+		(*SidecarEvent_StepResult_)(nil),
 	}
-	file_common_proto_init()
-	file_config_proto_init()
-	file_descriptor_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_plugins_proto_rawDesc), len(file_plugins_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   4,
+			NumEnums:      4,
+			NumMessages:   26,
 			NumExtensions: 0,
 			NumServices:   2,
 		},
 		GoTypes:           file_plugins_proto_goTypes,
 		DependencyIndexes: file_plugins_proto_depIdxs,
+		EnumInfos:         file_plugins_proto_enumTypes,
 		MessageInfos:      file_plugins_proto_msgTypes,
 	}.Build()
 	File_plugins_proto = out.File
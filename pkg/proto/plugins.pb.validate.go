@@ -388,6 +388,35 @@ func (m *DriverTransaction) validate(all bool) error {
 
 	// no validation rules for IsolationLevel
 
+	if all {
+		switch v := interface{}(m.GetBulkInsert()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DriverTransactionValidationError{
+					field:  "BulkInsert",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DriverTransactionValidationError{
+					field:  "BulkInsert",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBulkInsert()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DriverTransactionValidationError{
+				field:  "BulkInsert",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return DriverTransactionMultiError(errors)
 	}
@@ -468,6 +497,175 @@ var _ interface {
 	ErrorName() string
 } = DriverTransactionValidationError{}
 
+// Validate checks the field values on DriverBulkInsert with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DriverBulkInsert) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DriverBulkInsert with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DriverBulkInsertMultiError, or nil if none found.
+func (m *DriverBulkInsert) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DriverBulkInsert) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for TableName
+
+	for idx, item := range m.GetRows() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DriverBulkInsertValidationError{
+						field:  fmt.Sprintf("Rows[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DriverBulkInsertValidationError{
+						field:  fmt.Sprintf("Rows[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DriverBulkInsertValidationError{
+					field:  fmt.Sprintf("Rows[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Method
+
+	if all {
+		switch v := interface{}(m.GetDbSpecific()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DriverBulkInsertValidationError{
+					field:  "DbSpecific",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DriverBulkInsertValidationError{
+					field:  "DbSpecific",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDbSpecific()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DriverBulkInsertValidationError{
+				field:  "DbSpecific",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DriverBulkInsertMultiError(errors)
+	}
+
+	return nil
+}
+
+// DriverBulkInsertMultiError is an error wrapping multiple validation errors
+// returned by DriverBulkInsert.ValidateAll() if the designated constraints
+// aren't met.
+type DriverBulkInsertMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DriverBulkInsertMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DriverBulkInsertMultiError) AllErrors() []error { return m }
+
+// DriverBulkInsertValidationError is the validation error returned by
+// DriverBulkInsert.Validate if the designated constraints aren't met.
+type DriverBulkInsertValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DriverBulkInsertValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DriverBulkInsertValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DriverBulkInsertValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DriverBulkInsertValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DriverBulkInsertValidationError) ErrorName() string { return "DriverBulkInsertValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DriverBulkInsertValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDriverBulkInsert.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DriverBulkInsertValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DriverBulkInsertValidationError{}
+
 // Validate checks the field values on DriverTransactionList with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -603,3 +801,2970 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = DriverTransactionListValidationError{}
+
+// Validate checks the field values on TransactionLogEntry with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *TransactionLogEntry) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TransactionLogEntry with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TransactionLogEntryMultiError, or nil if none found.
+func (m *TransactionLogEntry) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TransactionLogEntry) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TransactionLogEntryValidationError{
+					field:  "Time",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TransactionLogEntryValidationError{
+					field:  "Time",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TransactionLogEntryValidationError{
+				field:  "Time",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetTransaction()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TransactionLogEntryValidationError{
+					field:  "Transaction",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TransactionLogEntryValidationError{
+					field:  "Transaction",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTransaction()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TransactionLogEntryValidationError{
+				field:  "Transaction",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return TransactionLogEntryMultiError(errors)
+	}
+
+	return nil
+}
+
+// TransactionLogEntryMultiError is an error wrapping multiple validation
+// errors returned by TransactionLogEntry.ValidateAll() if the designated
+// constraints aren't met.
+type TransactionLogEntryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TransactionLogEntryMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TransactionLogEntryMultiError) AllErrors() []error { return m }
+
+// TransactionLogEntryValidationError is the validation error returned by
+// TransactionLogEntry.Validate if the designated constraints aren't met.
+type TransactionLogEntryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TransactionLogEntryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TransactionLogEntryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TransactionLogEntryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TransactionLogEntryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TransactionLogEntryValidationError) ErrorName() string {
+	return "TransactionLogEntryValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TransactionLogEntryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTransactionLogEntry.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TransactionLogEntryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TransactionLogEntryValidationError{}
+
+// Validate checks the field values on DriverTransactionResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DriverTransactionResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DriverTransactionResult with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DriverTransactionResultMultiError, or nil if none found.
+func (m *DriverTransactionResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DriverTransactionResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if all {
+		switch v := interface{}(m.GetStartTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DriverTransactionResultValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DriverTransactionResultValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DriverTransactionResultValidationError{
+				field:  "StartTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEndTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DriverTransactionResultValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DriverTransactionResultValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DriverTransactionResultValidationError{
+				field:  "EndTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Error
+
+	// no validation rules for ErrorKind
+
+	for idx, item := range m.GetQueries() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DriverTransactionResultValidationError{
+						field:  fmt.Sprintf("Queries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DriverTransactionResultValidationError{
+						field:  fmt.Sprintf("Queries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DriverTransactionResultValidationError{
+					field:  fmt.Sprintf("Queries[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Retries
+
+	// no validation rules for ErrorClass
+
+	if len(errors) > 0 {
+		return DriverTransactionResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// DriverTransactionResultMultiError is an error wrapping multiple validation
+// errors returned by DriverTransactionResult.ValidateAll() if the designated
+// constraints aren't met.
+type DriverTransactionResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DriverTransactionResultMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DriverTransactionResultMultiError) AllErrors() []error { return m }
+
+// DriverTransactionResultValidationError is the validation error returned by
+// DriverTransactionResult.Validate if the designated constraints aren't met.
+type DriverTransactionResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DriverTransactionResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DriverTransactionResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DriverTransactionResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DriverTransactionResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DriverTransactionResultValidationError) ErrorName() string {
+	return "DriverTransactionResultValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DriverTransactionResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDriverTransactionResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DriverTransactionResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DriverTransactionResultValidationError{}
+
+// Validate checks the field values on DriverQueryResult with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DriverQueryResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DriverQueryResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DriverQueryResultMultiError, or nil if none found.
+func (m *DriverQueryResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DriverQueryResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	if all {
+		switch v := interface{}(m.GetDuration()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DriverQueryResultValidationError{
+					field:  "Duration",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DriverQueryResultValidationError{
+					field:  "Duration",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDuration()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DriverQueryResultValidationError{
+				field:  "Duration",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for RowsAffected
+
+	// no validation rules for RowsReturned
+
+	if len(errors) > 0 {
+		return DriverQueryResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// DriverQueryResultMultiError is an error wrapping multiple validation errors
+// returned by DriverQueryResult.ValidateAll() if the designated constraints
+// aren't met.
+type DriverQueryResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DriverQueryResultMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DriverQueryResultMultiError) AllErrors() []error { return m }
+
+// DriverQueryResultValidationError is the validation error returned by
+// DriverQueryResult.Validate if the designated constraints aren't met.
+type DriverQueryResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DriverQueryResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DriverQueryResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DriverQueryResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DriverQueryResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DriverQueryResultValidationError) ErrorName() string {
+	return "DriverQueryResultValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DriverQueryResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDriverQueryResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DriverQueryResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DriverQueryResultValidationError{}
+
+// Validate checks the field values on DriverTransactionResultList with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DriverTransactionResultList) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DriverTransactionResultList with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DriverTransactionResultListMultiError, or nil if none found.
+func (m *DriverTransactionResultList) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DriverTransactionResultList) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DriverTransactionResultListValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DriverTransactionResultListValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DriverTransactionResultListValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return DriverTransactionResultListMultiError(errors)
+	}
+
+	return nil
+}
+
+// DriverTransactionResultListMultiError is an error wrapping multiple
+// validation errors returned by DriverTransactionResultList.ValidateAll() if
+// the designated constraints aren't met.
+type DriverTransactionResultListMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DriverTransactionResultListMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DriverTransactionResultListMultiError) AllErrors() []error { return m }
+
+// DriverTransactionResultListValidationError is the validation error returned
+// by DriverTransactionResultList.Validate if the designated constraints
+// aren't met.
+type DriverTransactionResultListValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DriverTransactionResultListValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DriverTransactionResultListValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DriverTransactionResultListValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DriverTransactionResultListValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DriverTransactionResultListValidationError) ErrorName() string {
+	return "DriverTransactionResultListValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DriverTransactionResultListValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDriverTransactionResultList.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DriverTransactionResultListValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DriverTransactionResultListValidationError{}
+
+// Validate checks the field values on DriverErrorDetails with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DriverErrorDetails) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DriverErrorDetails with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DriverErrorDetailsMultiError, or nil if none found.
+func (m *DriverErrorDetails) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DriverErrorDetails) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Class
+
+	// no validation rules for Kind
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return DriverErrorDetailsMultiError(errors)
+	}
+
+	return nil
+}
+
+// DriverErrorDetailsMultiError is an error wrapping multiple validation errors
+// returned by DriverErrorDetails.ValidateAll() if the designated constraints
+// aren't met.
+type DriverErrorDetailsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DriverErrorDetailsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DriverErrorDetailsMultiError) AllErrors() []error { return m }
+
+// DriverErrorDetailsValidationError is the validation error returned by
+// DriverErrorDetails.Validate if the designated constraints aren't met.
+type DriverErrorDetailsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DriverErrorDetailsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DriverErrorDetailsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DriverErrorDetailsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DriverErrorDetailsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DriverErrorDetailsValidationError) ErrorName() string {
+	return "DriverErrorDetailsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DriverErrorDetailsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDriverErrorDetails.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DriverErrorDetailsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DriverErrorDetailsValidationError{}
+
+// Validate checks the field values on DriverCapabilities with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DriverCapabilities) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DriverCapabilities with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DriverCapabilitiesMultiError, or nil if none found.
+func (m *DriverCapabilities) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DriverCapabilities) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DriverName
+
+	// no validation rules for DriverVersion
+
+	// no validation rules for ProtocolVersion
+
+	// no validation rules for StreamingBuild
+
+	// no validation rules for BatchRun
+
+	if len(errors) > 0 {
+		return DriverCapabilitiesMultiError(errors)
+	}
+
+	return nil
+}
+
+// DriverCapabilitiesMultiError is an error wrapping multiple validation errors
+// returned by DriverCapabilities.ValidateAll() if the designated constraints
+// aren't met.
+type DriverCapabilitiesMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DriverCapabilitiesMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DriverCapabilitiesMultiError) AllErrors() []error { return m }
+
+// DriverCapabilitiesValidationError is the validation error returned by
+// DriverCapabilities.Validate if the designated constraints aren't met.
+type DriverCapabilitiesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DriverCapabilitiesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DriverCapabilitiesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DriverCapabilitiesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DriverCapabilitiesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DriverCapabilitiesValidationError) ErrorName() string {
+	return "DriverCapabilitiesValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DriverCapabilitiesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDriverCapabilities.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DriverCapabilitiesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DriverCapabilitiesValidationError{}
+
+// Validate checks the field values on Metric with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Metric) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Metric with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in MetricMultiError, or nil if none found.
+func (m *Metric) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Metric) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Labels
+
+	// no validation rules for Value
+
+	if all {
+		switch v := interface{}(m.GetTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MetricValidationError{
+					field:  "Time",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MetricValidationError{
+					field:  "Time",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MetricValidationError{
+				field:  "Time",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return MetricMultiError(errors)
+	}
+
+	return nil
+}
+
+// MetricMultiError is an error wrapping multiple validation errors returned by
+// Metric.ValidateAll() if the designated constraints aren't met.
+type MetricMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MetricMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MetricMultiError) AllErrors() []error { return m }
+
+// MetricValidationError is the validation error returned by Metric.Validate if
+// the designated constraints aren't met.
+type MetricValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MetricValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MetricValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MetricValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MetricValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MetricValidationError) ErrorName() string { return "MetricValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MetricValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMetric.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MetricValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MetricValidationError{}
+
+// Validate checks the field values on Annotation with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Annotation) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Annotation with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AnnotationMultiError, or
+// nil if none found.
+func (m *Annotation) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Annotation) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Text
+
+	// no validation rules for Labels
+
+	if all {
+		switch v := interface{}(m.GetTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AnnotationValidationError{
+					field:  "Time",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AnnotationValidationError{
+					field:  "Time",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AnnotationValidationError{
+				field:  "Time",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AnnotationMultiError(errors)
+	}
+
+	return nil
+}
+
+// AnnotationMultiError is an error wrapping multiple validation errors
+// returned by Annotation.ValidateAll() if the designated constraints aren't met.
+type AnnotationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AnnotationMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AnnotationMultiError) AllErrors() []error { return m }
+
+// AnnotationValidationError is the validation error returned by
+// Annotation.Validate if the designated constraints aren't met.
+type AnnotationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AnnotationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AnnotationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AnnotationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AnnotationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AnnotationValidationError) ErrorName() string { return "AnnotationValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AnnotationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAnnotation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AnnotationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AnnotationValidationError{}
+
+// Validate checks the field values on SidecarReport with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SidecarReport) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SidecarReport with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SidecarReportMultiError, or
+// nil if none found.
+func (m *SidecarReport) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SidecarReport) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Source
+
+	for idx, item := range m.GetMetrics() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SidecarReportValidationError{
+						field:  fmt.Sprintf("Metrics[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SidecarReportValidationError{
+						field:  fmt.Sprintf("Metrics[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SidecarReportValidationError{
+					field:  fmt.Sprintf("Metrics[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetAnnotations() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SidecarReportValidationError{
+						field:  fmt.Sprintf("Annotations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SidecarReportValidationError{
+						field:  fmt.Sprintf("Annotations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SidecarReportValidationError{
+					field:  fmt.Sprintf("Annotations[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SidecarReportMultiError(errors)
+	}
+
+	return nil
+}
+
+// SidecarReportMultiError is an error wrapping multiple validation errors
+// returned by SidecarReport.ValidateAll() if the designated constraints
+// aren't met.
+type SidecarReportMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SidecarReportMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SidecarReportMultiError) AllErrors() []error { return m }
+
+// SidecarReportValidationError is the validation error returned by
+// SidecarReport.Validate if the designated constraints aren't met.
+type SidecarReportValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SidecarReportValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SidecarReportValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SidecarReportValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SidecarReportValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SidecarReportValidationError) ErrorName() string { return "SidecarReportValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SidecarReportValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSidecarReport.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SidecarReportValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SidecarReportValidationError{}
+
+// Validate checks the field values on ExecutionSummary with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ExecutionSummary) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExecutionSummary with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExecutionSummaryMultiError, or nil if none found.
+func (m *ExecutionSummary) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExecutionSummary) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetStartTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExecutionSummaryValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExecutionSummaryValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExecutionSummaryValidationError{
+				field:  "StartTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetDuration()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExecutionSummaryValidationError{
+					field:  "Duration",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExecutionSummaryValidationError{
+					field:  "Duration",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDuration()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExecutionSummaryValidationError{
+				field:  "Duration",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Transactions
+
+	// no validation rules for Errors
+
+	// no validation rules for Error
+
+	if len(errors) > 0 {
+		return ExecutionSummaryMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExecutionSummaryMultiError is an error wrapping multiple validation errors
+// returned by ExecutionSummary.ValidateAll() if the designated constraints
+// aren't met.
+type ExecutionSummaryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExecutionSummaryMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExecutionSummaryMultiError) AllErrors() []error { return m }
+
+// ExecutionSummaryValidationError is the validation error returned by
+// ExecutionSummary.Validate if the designated constraints aren't met.
+type ExecutionSummaryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExecutionSummaryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExecutionSummaryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExecutionSummaryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExecutionSummaryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExecutionSummaryValidationError) ErrorName() string { return "ExecutionSummaryValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ExecutionSummaryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExecutionSummary.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExecutionSummaryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExecutionSummaryValidationError{}
+
+// Validate checks the field values on SidecarEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SidecarEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SidecarEvent with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SidecarEventMultiError, or
+// nil if none found.
+func (m *SidecarEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SidecarEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SidecarEventValidationError{
+					field:  "Time",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SidecarEventValidationError{
+					field:  "Time",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SidecarEventValidationError{
+				field:  "Time",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetContext()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SidecarEventValidationError{
+					field:  "Context",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SidecarEventValidationError{
+					field:  "Context",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetContext()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SidecarEventValidationError{
+				field:  "Context",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	switch v := m.Type.(type) {
+	case *SidecarEvent_RunStart_:
+		if v == nil {
+			err := SidecarEventValidationError{
+				field:  "Type",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetRunStart()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SidecarEventValidationError{
+						field:  "RunStart",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SidecarEventValidationError{
+						field:  "RunStart",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetRunStart()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SidecarEventValidationError{
+					field:  "RunStart",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *SidecarEvent_RunEnd_:
+		if v == nil {
+			err := SidecarEventValidationError{
+				field:  "Type",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetRunEnd()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SidecarEventValidationError{
+						field:  "RunEnd",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SidecarEventValidationError{
+						field:  "RunEnd",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetRunEnd()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SidecarEventValidationError{
+					field:  "RunEnd",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *SidecarEvent_UnitStart_:
+		if v == nil {
+			err := SidecarEventValidationError{
+				field:  "Type",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetUnitStart()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SidecarEventValidationError{
+						field:  "UnitStart",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SidecarEventValidationError{
+						field:  "UnitStart",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetUnitStart()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SidecarEventValidationError{
+					field:  "UnitStart",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *SidecarEvent_UnitEnd_:
+		if v == nil {
+			err := SidecarEventValidationError{
+				field:  "Type",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetUnitEnd()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SidecarEventValidationError{
+						field:  "UnitEnd",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SidecarEventValidationError{
+						field:  "UnitEnd",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetUnitEnd()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SidecarEventValidationError{
+					field:  "UnitEnd",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *SidecarEvent_Metrics:
+		if v == nil {
+			err := SidecarEventValidationError{
+				field:  "Type",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetMetrics()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SidecarEventValidationError{
+						field:  "Metrics",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SidecarEventValidationError{
+						field:  "Metrics",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetMetrics()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SidecarEventValidationError{
+					field:  "Metrics",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *SidecarEvent_Error_:
+		if v == nil {
+			err := SidecarEventValidationError{
+				field:  "Type",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetError()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SidecarEventValidationError{
+						field:  "Error",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SidecarEventValidationError{
+						field:  "Error",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetError()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SidecarEventValidationError{
+					field:  "Error",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *SidecarEvent_StepResult_:
+		if v == nil {
+			err := SidecarEventValidationError{
+				field:  "Type",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetStepResult()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SidecarEventValidationError{
+						field:  "StepResult",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SidecarEventValidationError{
+						field:  "StepResult",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetStepResult()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SidecarEventValidationError{
+					field:  "StepResult",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return SidecarEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// SidecarEventMultiError is an error wrapping multiple validation errors
+// returned by SidecarEvent.ValidateAll() if the designated constraints aren't met.
+type SidecarEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SidecarEventMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SidecarEventMultiError) AllErrors() []error { return m }
+
+// SidecarEventValidationError is the validation error returned by
+// SidecarEvent.Validate if the designated constraints aren't met.
+type SidecarEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SidecarEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SidecarEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SidecarEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SidecarEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SidecarEventValidationError) ErrorName() string { return "SidecarEventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SidecarEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSidecarEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SidecarEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SidecarEventValidationError{}
+
+// Validate checks the field values on StepDecision with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *StepDecision) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StepDecision with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in StepDecisionMultiError, or
+// nil if none found.
+func (m *StepDecision) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StepDecision) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Action
+
+	if all {
+		switch v := interface{}(m.GetPollInterval()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StepDecisionValidationError{
+					field:  "PollInterval",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StepDecisionValidationError{
+					field:  "PollInterval",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPollInterval()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StepDecisionValidationError{
+				field:  "PollInterval",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Reason
+
+	if len(errors) > 0 {
+		return StepDecisionMultiError(errors)
+	}
+
+	return nil
+}
+
+// StepDecisionMultiError is an error wrapping multiple validation errors
+// returned by StepDecision.ValidateAll() if the designated constraints aren't met.
+type StepDecisionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StepDecisionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StepDecisionMultiError) AllErrors() []error { return m }
+
+// StepDecisionValidationError is the validation error returned by
+// StepDecision.Validate if the designated constraints aren't met.
+type StepDecisionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StepDecisionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StepDecisionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StepDecisionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StepDecisionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StepDecisionValidationError) ErrorName() string { return "StepDecisionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StepDecisionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStepDecision.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StepDecisionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StepDecisionValidationError{}
+
+// Validate checks the field values on SidecarEvent_RunStart with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SidecarEvent_RunStart) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SidecarEvent_RunStart with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SidecarEvent_RunStartMultiError, or nil if none found.
+func (m *SidecarEvent_RunStart) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SidecarEvent_RunStart) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return SidecarEvent_RunStartMultiError(errors)
+	}
+
+	return nil
+}
+
+// SidecarEvent_RunStartMultiError is an error wrapping multiple validation
+// errors returned by SidecarEvent_RunStart.ValidateAll() if the designated
+// constraints aren't met.
+type SidecarEvent_RunStartMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SidecarEvent_RunStartMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SidecarEvent_RunStartMultiError) AllErrors() []error { return m }
+
+// SidecarEvent_RunStartValidationError is the validation error returned by
+// SidecarEvent_RunStart.Validate if the designated constraints aren't met.
+type SidecarEvent_RunStartValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SidecarEvent_RunStartValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SidecarEvent_RunStartValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SidecarEvent_RunStartValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SidecarEvent_RunStartValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SidecarEvent_RunStartValidationError) ErrorName() string {
+	return "SidecarEvent_RunStartValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SidecarEvent_RunStartValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSidecarEvent_RunStart.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SidecarEvent_RunStartValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SidecarEvent_RunStartValidationError{}
+
+// Validate checks the field values on SidecarEvent_RunEnd with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SidecarEvent_RunEnd) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SidecarEvent_RunEnd with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SidecarEvent_RunEndMultiError, or nil if none found.
+func (m *SidecarEvent_RunEnd) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SidecarEvent_RunEnd) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSummary()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SidecarEvent_RunEndValidationError{
+					field:  "Summary",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SidecarEvent_RunEndValidationError{
+					field:  "Summary",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSummary()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SidecarEvent_RunEndValidationError{
+				field:  "Summary",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SidecarEvent_RunEndMultiError(errors)
+	}
+
+	return nil
+}
+
+// SidecarEvent_RunEndMultiError is an error wrapping multiple validation
+// errors returned by SidecarEvent_RunEnd.ValidateAll() if the designated
+// constraints aren't met.
+type SidecarEvent_RunEndMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SidecarEvent_RunEndMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SidecarEvent_RunEndMultiError) AllErrors() []error { return m }
+
+// SidecarEvent_RunEndValidationError is the validation error returned by
+// SidecarEvent_RunEnd.Validate if the designated constraints aren't met.
+type SidecarEvent_RunEndValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SidecarEvent_RunEndValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SidecarEvent_RunEndValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SidecarEvent_RunEndValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SidecarEvent_RunEndValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SidecarEvent_RunEndValidationError) ErrorName() string {
+	return "SidecarEvent_RunEndValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SidecarEvent_RunEndValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSidecarEvent_RunEnd.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SidecarEvent_RunEndValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SidecarEvent_RunEndValidationError{}
+
+// Validate checks the field values on SidecarEvent_UnitStart with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SidecarEvent_UnitStart) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SidecarEvent_UnitStart with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SidecarEvent_UnitStartMultiError, or nil if none found.
+func (m *SidecarEvent_UnitStart) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SidecarEvent_UnitStart) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetUnit()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SidecarEvent_UnitStartValidationError{
+					field:  "Unit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SidecarEvent_UnitStartValidationError{
+					field:  "Unit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUnit()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SidecarEvent_UnitStartValidationError{
+				field:  "Unit",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SidecarEvent_UnitStartMultiError(errors)
+	}
+
+	return nil
+}
+
+// SidecarEvent_UnitStartMultiError is an error wrapping multiple validation
+// errors returned by SidecarEvent_UnitStart.ValidateAll() if the designated
+// constraints aren't met.
+type SidecarEvent_UnitStartMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SidecarEvent_UnitStartMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SidecarEvent_UnitStartMultiError) AllErrors() []error { return m }
+
+// SidecarEvent_UnitStartValidationError is the validation error returned by
+// SidecarEvent_UnitStart.Validate if the designated constraints aren't met.
+type SidecarEvent_UnitStartValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SidecarEvent_UnitStartValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SidecarEvent_UnitStartValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SidecarEvent_UnitStartValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SidecarEvent_UnitStartValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SidecarEvent_UnitStartValidationError) ErrorName() string {
+	return "SidecarEvent_UnitStartValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SidecarEvent_UnitStartValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSidecarEvent_UnitStart.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SidecarEvent_UnitStartValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SidecarEvent_UnitStartValidationError{}
+
+// Validate checks the field values on SidecarEvent_UnitEnd with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SidecarEvent_UnitEnd) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SidecarEvent_UnitEnd with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SidecarEvent_UnitEndMultiError, or nil if none found.
+func (m *SidecarEvent_UnitEnd) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SidecarEvent_UnitEnd) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetUnit()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SidecarEvent_UnitEndValidationError{
+					field:  "Unit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SidecarEvent_UnitEndValidationError{
+					field:  "Unit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUnit()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SidecarEvent_UnitEndValidationError{
+				field:  "Unit",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetSummary()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SidecarEvent_UnitEndValidationError{
+					field:  "Summary",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SidecarEvent_UnitEndValidationError{
+					field:  "Summary",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSummary()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SidecarEvent_UnitEndValidationError{
+				field:  "Summary",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SidecarEvent_UnitEndMultiError(errors)
+	}
+
+	return nil
+}
+
+// SidecarEvent_UnitEndMultiError is an error wrapping multiple validation
+// errors returned by SidecarEvent_UnitEnd.ValidateAll() if the designated
+// constraints aren't met.
+type SidecarEvent_UnitEndMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SidecarEvent_UnitEndMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SidecarEvent_UnitEndMultiError) AllErrors() []error { return m }
+
+// SidecarEvent_UnitEndValidationError is the validation error returned by
+// SidecarEvent_UnitEnd.Validate if the designated constraints aren't met.
+type SidecarEvent_UnitEndValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SidecarEvent_UnitEndValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SidecarEvent_UnitEndValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SidecarEvent_UnitEndValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SidecarEvent_UnitEndValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SidecarEvent_UnitEndValidationError) ErrorName() string {
+	return "SidecarEvent_UnitEndValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SidecarEvent_UnitEndValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSidecarEvent_UnitEnd.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SidecarEvent_UnitEndValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SidecarEvent_UnitEndValidationError{}
+
+// Validate checks the field values on SidecarEvent_MetricSnapshot with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SidecarEvent_MetricSnapshot) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SidecarEvent_MetricSnapshot with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SidecarEvent_MetricSnapshotMultiError, or nil if none found.
+func (m *SidecarEvent_MetricSnapshot) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SidecarEvent_MetricSnapshot) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetMetrics() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SidecarEvent_MetricSnapshotValidationError{
+						field:  fmt.Sprintf("Metrics[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SidecarEvent_MetricSnapshotValidationError{
+						field:  fmt.Sprintf("Metrics[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SidecarEvent_MetricSnapshotValidationError{
+					field:  fmt.Sprintf("Metrics[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SidecarEvent_MetricSnapshotMultiError(errors)
+	}
+
+	return nil
+}
+
+// SidecarEvent_MetricSnapshotMultiError is an error wrapping multiple
+// validation errors returned by SidecarEvent_MetricSnapshot.ValidateAll() if
+// the designated constraints aren't met.
+type SidecarEvent_MetricSnapshotMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SidecarEvent_MetricSnapshotMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SidecarEvent_MetricSnapshotMultiError) AllErrors() []error { return m }
+
+// SidecarEvent_MetricSnapshotValidationError is the validation error returned
+// by SidecarEvent_MetricSnapshot.Validate if the designated constraints
+// aren't met.
+type SidecarEvent_MetricSnapshotValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SidecarEvent_MetricSnapshotValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SidecarEvent_MetricSnapshotValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SidecarEvent_MetricSnapshotValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SidecarEvent_MetricSnapshotValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SidecarEvent_MetricSnapshotValidationError) ErrorName() string {
+	return "SidecarEvent_MetricSnapshotValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SidecarEvent_MetricSnapshotValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSidecarEvent_MetricSnapshot.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SidecarEvent_MetricSnapshotValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SidecarEvent_MetricSnapshotValidationError{}
+
+// Validate checks the field values on SidecarEvent_Error with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SidecarEvent_Error) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SidecarEvent_Error with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SidecarEvent_ErrorMultiError, or nil if none found.
+func (m *SidecarEvent_Error) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SidecarEvent_Error) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetUnit()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SidecarEvent_ErrorValidationError{
+					field:  "Unit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SidecarEvent_ErrorValidationError{
+					field:  "Unit",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUnit()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SidecarEvent_ErrorValidationError{
+				field:  "Unit",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Message
+
+	// no validation rules for Class
+
+	// no validation rules for Kind
+
+	if len(errors) > 0 {
+		return SidecarEvent_ErrorMultiError(errors)
+	}
+
+	return nil
+}
+
+// SidecarEvent_ErrorMultiError is an error wrapping multiple validation errors
+// returned by SidecarEvent_Error.ValidateAll() if the designated constraints
+// aren't met.
+type SidecarEvent_ErrorMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SidecarEvent_ErrorMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SidecarEvent_ErrorMultiError) AllErrors() []error { return m }
+
+// SidecarEvent_ErrorValidationError is the validation error returned by
+// SidecarEvent_Error.Validate if the designated constraints aren't met.
+type SidecarEvent_ErrorValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SidecarEvent_ErrorValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SidecarEvent_ErrorValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SidecarEvent_ErrorValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SidecarEvent_ErrorValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SidecarEvent_ErrorValidationError) ErrorName() string {
+	return "SidecarEvent_ErrorValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SidecarEvent_ErrorValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSidecarEvent_Error.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SidecarEvent_ErrorValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SidecarEvent_ErrorValidationError{}
+
+// Validate checks the field values on SidecarEvent_StepResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SidecarEvent_StepResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SidecarEvent_StepResult with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SidecarEvent_StepResultMultiError, or nil if none found.
+func (m *SidecarEvent_StepResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SidecarEvent_StepResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSummary()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SidecarEvent_StepResultValidationError{
+					field:  "Summary",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SidecarEvent_StepResultValidationError{
+					field:  "Summary",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSummary()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SidecarEvent_StepResultValidationError{
+				field:  "Summary",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SidecarEvent_StepResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// SidecarEvent_StepResultMultiError is an error wrapping multiple validation
+// errors returned by SidecarEvent_StepResult.ValidateAll() if the designated
+// constraints aren't met.
+type SidecarEvent_StepResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SidecarEvent_StepResultMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SidecarEvent_StepResultMultiError) AllErrors() []error { return m }
+
+// SidecarEvent_StepResultValidationError is the validation error returned by
+// SidecarEvent_StepResult.Validate if the designated constraints aren't met.
+type SidecarEvent_StepResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SidecarEvent_StepResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SidecarEvent_StepResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SidecarEvent_StepResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SidecarEvent_StepResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SidecarEvent_StepResultValidationError) ErrorName() string {
+	return "SidecarEvent_StepResultValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SidecarEvent_StepResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSidecarEvent_StepResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SidecarEvent_StepResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SidecarEvent_StepResultValidationError{}
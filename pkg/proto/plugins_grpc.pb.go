@@ -25,6 +25,9 @@ const (
 	DriverPlugin_BuildTransactionsFromUnitStream_FullMethodName = "/stroppy.DriverPlugin/BuildTransactionsFromUnitStream"
 	DriverPlugin_RunTransaction_FullMethodName                  = "/stroppy.DriverPlugin/RunTransaction"
 	DriverPlugin_Teardown_FullMethodName                        = "/stroppy.DriverPlugin/Teardown"
+	DriverPlugin_GetCapabilities_FullMethodName                 = "/stroppy.DriverPlugin/GetCapabilities"
+	DriverPlugin_RunTransactions_FullMethodName                 = "/stroppy.DriverPlugin/RunTransactions"
+	DriverPlugin_RunTransactionBatch_FullMethodName             = "/stroppy.DriverPlugin/RunTransactionBatch"
 )
 
 // DriverPluginClient is the client API for DriverPlugin service.
@@ -53,11 +56,25 @@ type DriverPluginClient interface {
 	// RunTransaction is called once for each built transaction.
 	// The driver must implement the transactional context of operations.
 	// If the transaction has a single query, the driver may ignore transactional wrapping and run the query directly.
-	RunTransaction(ctx context.Context, in *DriverTransaction, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Transaction failures are reported in the result, plugins of older versions return an empty result.
+	RunTransaction(ctx context.Context, in *DriverTransaction, opts ...grpc.CallOption) (*DriverTransactionResult, error)
 	// *
 	// Teardown is called once after the benchmark ends.
 	// Needs to clean up resources.
 	Teardown(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// *
+	// GetCapabilities reports optional features supported by the driver.
+	// Available since plugin protocol version 2.
+	GetCapabilities(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*DriverCapabilities, error)
+	// *
+	// RunTransactions runs transactions pipelined over a single stream.
+	// Results are sent in the order of transactions, a failed transaction does not stop the stream.
+	// Available since plugin protocol version 3.
+	RunTransactions(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[DriverTransaction, DriverTransactionResult], error)
+	// *
+	// RunTransactionBatch runs a batch of transactions in a single call and returns their results in order.
+	// Available since plugin protocol version 3.
+	RunTransactionBatch(ctx context.Context, in *DriverTransactionList, opts ...grpc.CallOption) (*DriverTransactionResultList, error)
 }
 
 type driverPluginClient struct {
@@ -107,9 +124,9 @@ func (c *driverPluginClient) BuildTransactionsFromUnitStream(ctx context.Context
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type DriverPlugin_BuildTransactionsFromUnitStreamClient = grpc.ServerStreamingClient[DriverTransaction]
 
-func (c *driverPluginClient) RunTransaction(ctx context.Context, in *DriverTransaction, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *driverPluginClient) RunTransaction(ctx context.Context, in *DriverTransaction, opts ...grpc.CallOption) (*DriverTransactionResult, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(emptypb.Empty)
+	out := new(DriverTransactionResult)
 	err := c.cc.Invoke(ctx, DriverPlugin_RunTransaction_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -127,6 +144,39 @@ func (c *driverPluginClient) Teardown(ctx context.Context, in *emptypb.Empty, op
 	return out, nil
 }
 
+func (c *driverPluginClient) GetCapabilities(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*DriverCapabilities, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DriverCapabilities)
+	err := c.cc.Invoke(ctx, DriverPlugin_GetCapabilities_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverPluginClient) RunTransactions(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[DriverTransaction, DriverTransactionResult], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &DriverPlugin_ServiceDesc.Streams[1], DriverPlugin_RunTransactions_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[DriverTransaction, DriverTransactionResult]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DriverPlugin_RunTransactionsClient = grpc.BidiStreamingClient[DriverTransaction, DriverTransactionResult]
+
+func (c *driverPluginClient) RunTransactionBatch(ctx context.Context, in *DriverTransactionList, opts ...grpc.CallOption) (*DriverTransactionResultList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DriverTransactionResultList)
+	err := c.cc.Invoke(ctx, DriverPlugin_RunTransactionBatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DriverPluginServer is the server API for DriverPlugin service.
 // All implementations must embed UnimplementedDriverPluginServer
 // for forward compatibility.
@@ -153,11 +203,25 @@ type DriverPluginServer interface {
 	// RunTransaction is called once for each built transaction.
 	// The driver must implement the transactional context of operations.
 	// If the transaction has a single query, the driver may ignore transactional wrapping and run the query directly.
-	RunTransaction(context.Context, *DriverTransaction) (*emptypb.Empty, error)
+	// Transaction failures are reported in the result, plugins of older versions return an empty result.
+	RunTransaction(context.Context, *DriverTransaction) (*DriverTransactionResult, error)
 	// *
 	// Teardown is called once after the benchmark ends.
 	// Needs to clean up resources.
 	Teardown(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
+	// *
+	// GetCapabilities reports optional features supported by the driver.
+	// Available since plugin protocol version 2.
+	GetCapabilities(context.Context, *emptypb.Empty) (*DriverCapabilities, error)
+	// *
+	// RunTransactions runs transactions pipelined over a single stream.
+	// Results are sent in the order of transactions, a failed transaction does not stop the stream.
+	// Available since plugin protocol version 3.
+	RunTransactions(grpc.BidiStreamingServer[DriverTransaction, DriverTransactionResult]) error
+	// *
+	// RunTransactionBatch runs a batch of transactions in a single call and returns their results in order.
+	// Available since plugin protocol version 3.
+	RunTransactionBatch(context.Context, *DriverTransactionList) (*DriverTransactionResultList, error)
 	mustEmbedUnimplementedDriverPluginServer()
 }
 
@@ -177,12 +241,21 @@ func (UnimplementedDriverPluginServer) BuildTransactionsFromUnit(context.Context
 func (UnimplementedDriverPluginServer) BuildTransactionsFromUnitStream(*UnitBuildContext, grpc.ServerStreamingServer[DriverTransaction]) error {
 	return status.Errorf(codes.Unimplemented, "method BuildTransactionsFromUnitStream not implemented")
 }
-func (UnimplementedDriverPluginServer) RunTransaction(context.Context, *DriverTransaction) (*emptypb.Empty, error) {
+func (UnimplementedDriverPluginServer) RunTransaction(context.Context, *DriverTransaction) (*DriverTransactionResult, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RunTransaction not implemented")
 }
 func (UnimplementedDriverPluginServer) Teardown(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Teardown not implemented")
 }
+func (UnimplementedDriverPluginServer) GetCapabilities(context.Context, *emptypb.Empty) (*DriverCapabilities, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCapabilities not implemented")
+}
+func (UnimplementedDriverPluginServer) RunTransactions(grpc.BidiStreamingServer[DriverTransaction, DriverTransactionResult]) error {
+	return status.Errorf(codes.Unimplemented, "method RunTransactions not implemented")
+}
+func (UnimplementedDriverPluginServer) RunTransactionBatch(context.Context, *DriverTransactionList) (*DriverTransactionResultList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunTransactionBatch not implemented")
+}
 func (UnimplementedDriverPluginServer) mustEmbedUnimplementedDriverPluginServer() {}
 func (UnimplementedDriverPluginServer) testEmbeddedByValue()                      {}
 
@@ -287,6 +360,49 @@ func _DriverPlugin_Teardown_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DriverPlugin_GetCapabilities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverPluginServer).GetCapabilities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DriverPlugin_GetCapabilities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverPluginServer).GetCapabilities(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DriverPlugin_RunTransactions_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(DriverPluginServer).RunTransactions(&grpc.GenericServerStream[DriverTransaction, DriverTransactionResult]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DriverPlugin_RunTransactionsServer = grpc.BidiStreamingServer[DriverTransaction, DriverTransactionResult]
+
+func _DriverPlugin_RunTransactionBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DriverTransactionList)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverPluginServer).RunTransactionBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DriverPlugin_RunTransactionBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverPluginServer).RunTransactionBatch(ctx, req.(*DriverTransactionList))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DriverPlugin_ServiceDesc is the grpc.ServiceDesc for DriverPlugin service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -310,6 +426,14 @@ var DriverPlugin_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Teardown",
 			Handler:    _DriverPlugin_Teardown_Handler,
 		},
+		{
+			MethodName: "GetCapabilities",
+			Handler:    _DriverPlugin_GetCapabilities_Handler,
+		},
+		{
+			MethodName: "RunTransactionBatch",
+			Handler:    _DriverPlugin_RunTransactionBatch_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -317,6 +441,12 @@ var DriverPlugin_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _DriverPlugin_BuildTransactionsFromUnitStream_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "RunTransactions",
+			Handler:       _DriverPlugin_RunTransactions_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "plugins.proto",
 }
@@ -325,6 +455,8 @@ const (
 	SidecarPlugin_Initialize_FullMethodName  = "/stroppy.SidecarPlugin/Initialize"
 	SidecarPlugin_OnStepStart_FullMethodName = "/stroppy.SidecarPlugin/OnStepStart"
 	SidecarPlugin_OnStepEnd_FullMethodName   = "/stroppy.SidecarPlugin/OnStepEnd"
+	SidecarPlugin_OnEvent_FullMethodName     = "/stroppy.SidecarPlugin/OnEvent"
+	SidecarPlugin_Reports_FullMethodName     = "/stroppy.SidecarPlugin/Reports"
 	SidecarPlugin_Teardown_FullMethodName    = "/stroppy.SidecarPlugin/Teardown"
 )
 
@@ -340,12 +472,21 @@ type SidecarPluginClient interface {
 	// Used to initialize resources of SidecarPlugin.
 	Initialize(ctx context.Context, in *StepContext, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// *
-	// OnStepStart is called once before each step starts.
-	OnStepStart(ctx context.Context, in *StepContext, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// OnStepStart is called before each step starts and repeated while the sidecar delays it.
+	// Sidecars which do not decide answer with an empty message, which means proceed.
+	OnStepStart(ctx context.Context, in *StepContext, opts ...grpc.CallOption) (*StepDecision, error)
 	// *
 	// OnStepEnd is called once after each step ends.
 	OnStepEnd(ctx context.Context, in *StepContext, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// *
+	// OnEvent is called on run, unit and metric events, see SidecarEvent.
+	// Added in protocol version 2.
+	OnEvent(ctx context.Context, in *SidecarEvent, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// *
+	// Reports streams metrics and annotations collected by the sidecar until the stream is canceled.
+	// Added in protocol version 3.
+	Reports(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SidecarReport], error)
+	// *
 	// Teardown is called once after the benchmark ends.
 	// Used to clean up resources.
 	Teardown(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error)
@@ -369,9 +510,9 @@ func (c *sidecarPluginClient) Initialize(ctx context.Context, in *StepContext, o
 	return out, nil
 }
 
-func (c *sidecarPluginClient) OnStepStart(ctx context.Context, in *StepContext, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *sidecarPluginClient) OnStepStart(ctx context.Context, in *StepContext, opts ...grpc.CallOption) (*StepDecision, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(emptypb.Empty)
+	out := new(StepDecision)
 	err := c.cc.Invoke(ctx, SidecarPlugin_OnStepStart_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -389,6 +530,35 @@ func (c *sidecarPluginClient) OnStepEnd(ctx context.Context, in *StepContext, op
 	return out, nil
 }
 
+func (c *sidecarPluginClient) OnEvent(ctx context.Context, in *SidecarEvent, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, SidecarPlugin_OnEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sidecarPluginClient) Reports(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SidecarReport], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SidecarPlugin_ServiceDesc.Streams[0], SidecarPlugin_Reports_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[emptypb.Empty, SidecarReport]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SidecarPlugin_ReportsClient = grpc.ServerStreamingClient[SidecarReport]
+
 func (c *sidecarPluginClient) Teardown(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
@@ -411,12 +581,21 @@ type SidecarPluginServer interface {
 	// Used to initialize resources of SidecarPlugin.
 	Initialize(context.Context, *StepContext) (*emptypb.Empty, error)
 	// *
-	// OnStepStart is called once before each step starts.
-	OnStepStart(context.Context, *StepContext) (*emptypb.Empty, error)
+	// OnStepStart is called before each step starts and repeated while the sidecar delays it.
+	// Sidecars which do not decide answer with an empty message, which means proceed.
+	OnStepStart(context.Context, *StepContext) (*StepDecision, error)
 	// *
 	// OnStepEnd is called once after each step ends.
 	OnStepEnd(context.Context, *StepContext) (*emptypb.Empty, error)
 	// *
+	// OnEvent is called on run, unit and metric events, see SidecarEvent.
+	// Added in protocol version 2.
+	OnEvent(context.Context, *SidecarEvent) (*emptypb.Empty, error)
+	// *
+	// Reports streams metrics and annotations collected by the sidecar until the stream is canceled.
+	// Added in protocol version 3.
+	Reports(*emptypb.Empty, grpc.ServerStreamingServer[SidecarReport]) error
+	// *
 	// Teardown is called once after the benchmark ends.
 	// Used to clean up resources.
 	Teardown(context.Context, *emptypb.Empty) (*emptypb.Empty, error)
@@ -433,12 +612,18 @@ type UnimplementedSidecarPluginServer struct{}
 func (UnimplementedSidecarPluginServer) Initialize(context.Context, *StepContext) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Initialize not implemented")
 }
-func (UnimplementedSidecarPluginServer) OnStepStart(context.Context, *StepContext) (*emptypb.Empty, error) {
+func (UnimplementedSidecarPluginServer) OnStepStart(context.Context, *StepContext) (*StepDecision, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method OnStepStart not implemented")
 }
 func (UnimplementedSidecarPluginServer) OnStepEnd(context.Context, *StepContext) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method OnStepEnd not implemented")
 }
+func (UnimplementedSidecarPluginServer) OnEvent(context.Context, *SidecarEvent) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OnEvent not implemented")
+}
+func (UnimplementedSidecarPluginServer) Reports(*emptypb.Empty, grpc.ServerStreamingServer[SidecarReport]) error {
+	return status.Errorf(codes.Unimplemented, "method Reports not implemented")
+}
 func (UnimplementedSidecarPluginServer) Teardown(context.Context, *emptypb.Empty) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Teardown not implemented")
 }
@@ -517,6 +702,35 @@ func _SidecarPlugin_OnStepEnd_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SidecarPlugin_OnEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SidecarEvent)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SidecarPluginServer).OnEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SidecarPlugin_OnEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SidecarPluginServer).OnEvent(ctx, req.(*SidecarEvent))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SidecarPlugin_Reports_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(emptypb.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SidecarPluginServer).Reports(m, &grpc.GenericServerStream[emptypb.Empty, SidecarReport]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SidecarPlugin_ReportsServer = grpc.ServerStreamingServer[SidecarReport]
+
 func _SidecarPlugin_Teardown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
@@ -554,11 +768,21 @@ var SidecarPlugin_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "OnStepEnd",
 			Handler:    _SidecarPlugin_OnStepEnd_Handler,
 		},
+		{
+			MethodName: "OnEvent",
+			Handler:    _SidecarPlugin_OnEvent_Handler,
+		},
 		{
 			MethodName: "Teardown",
 			Handler:    _SidecarPlugin_Teardown_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Reports",
+			Handler:       _SidecarPlugin_Reports_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "plugins.proto",
 }
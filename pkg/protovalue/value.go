@@ -23,6 +23,12 @@ func listValueToSlice(value *stroppy.Value_List) ([]any, error) {
 	return result, nil
 }
 
+// ValueListToSlice converts items of value to Go values: NULL becomes Null, decimals become decimal.Decimal,
+// datetimes become time.Time, structs become maps and lists become slices. Decimal parse errors are returned.
+func ValueListToSlice(value *stroppy.Value_List) ([]any, error) {
+	return listValueToSlice(value)
+}
+
 func valueToAny(value *stroppy.Value) (any, error) {
 	switch value.GetType().(type) {
 	case *stroppy.Value_Null:
@@ -0,0 +1,271 @@
+package queries
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/stroppy-io/stroppy-core/pkg/generate"
+	stroppy "github.com/stroppy-io/stroppy-core/pkg/proto"
+)
+
+var (
+	ErrEmptyParamMatch       = errors.New("param replace regex matches empty string")
+	ErrOverlappingParamMatch = errors.New("param replace regex matches overlap")
+)
+
+// DefaultReplaceRegex returns the pattern used when QueryParamDescriptor has no ReplaceRegex: "${<param_name>}".
+func DefaultReplaceRegex(paramName string) string {
+	return regexp.QuoteMeta("${" + paramName + "}")
+}
+
+// ParamSeed derives a deterministic seed for a named entity (e.g. query + param) from the run seed,
+// so that adding or reordering params does not change values generated for the others.
+func ParamSeed(seed uint64, scope ...string) uint64 {
+	hash := fnv.New64a()
+
+	var seedBytes [8]byte
+
+	binary.LittleEndian.PutUint64(seedBytes[:], seed)
+	_, _ = hash.Write(seedBytes[:])
+
+	for _, name := range scope {
+		_, _ = hash.Write([]byte(name))
+		_, _ = hash.Write([]byte{0})
+	}
+
+	return hash.Sum64()
+}
+
+type paramMatch struct {
+	start int
+	end   int
+	param int
+}
+
+// queryTemplate is a SQL text rewritten to driver placeholders with
+// the order in which descriptor params are bound to them and their bind names.
+type queryTemplate struct {
+	sql   string
+	args  []int
+	names []string
+}
+
+// CompileParamRegex compiles ReplaceRegex of param or DefaultReplaceRegex when it is empty.
+func CompileParamRegex(param *stroppy.QueryParamDescriptor) (*regexp.Regexp, error) {
+	pattern := param.GetReplaceRegex()
+	if pattern == "" {
+		pattern = DefaultReplaceRegex(param.GetName())
+	}
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compile replace regex for param '%s': %w", param.GetName(), err)
+	}
+
+	return re, nil
+}
+
+func findParamMatches(descriptor *stroppy.QueryDescriptor) ([]paramMatch, error) {
+	matches := make([]paramMatch, 0)
+
+	for idx, param := range descriptor.GetParams() {
+		re, err := CompileParamRegex(param)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, loc := range re.FindAllStringIndex(descriptor.GetSql(), -1) {
+			if loc[0] == loc[1] {
+				return nil, fmt.Errorf("%w: param '%s'", ErrEmptyParamMatch, param.GetName())
+			}
+
+			matches = append(matches, paramMatch{start: loc[0], end: loc[1], param: idx})
+		}
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].start < matches[j].start
+	})
+
+	for i := 1; i < len(matches); i++ {
+		if matches[i].start < matches[i-1].end {
+			return nil, fmt.Errorf(
+				"%w: params '%s' and '%s'",
+				ErrOverlappingParamMatch,
+				descriptor.GetParams()[matches[i-1].param].GetName(),
+				descriptor.GetParams()[matches[i].param].GetName(),
+			)
+		}
+	}
+
+	return matches, nil
+}
+
+func newQueryTemplate(
+	descriptor *stroppy.QueryDescriptor,
+	style PlaceholderStyle,
+) (*queryTemplate, error) {
+	matches, err := findParamMatches(descriptor)
+	if err != nil {
+		return nil, err
+	}
+
+	paramNames := make([]string, 0, len(descriptor.GetParams()))
+	for _, param := range descriptor.GetParams() {
+		paramNames = append(paramNames, param.GetName())
+	}
+
+	var (
+		sql       strings.Builder
+		last      int
+		args      = make([]int, 0, len(matches))
+		names     = bindNames(paramNames)
+		positions = make(map[int]int)
+	)
+
+	for _, match := range matches {
+		sql.WriteString(descriptor.GetSql()[last:match.start])
+
+		position, bound := positions[match.param]
+		if style.Positional() || !bound {
+			args = append(args, match.param)
+			position = len(args)
+			positions[match.param] = position
+		}
+
+		sql.WriteString(style.Format(position, names[match.param]))
+
+		last = match.end
+	}
+
+	sql.WriteString(descriptor.GetSql()[last:])
+
+	return &queryTemplate{sql: sql.String(), args: args, names: names}, nil
+}
+
+// QueryBuilder produces DriverQuery objects from QueryDescriptor:
+// SQL is rewritten to the driver placeholder style and params are filled from their generation rules.
+// Named placeholders use param names made valid by replacing other characters with underscores.
+type QueryBuilder struct {
+	name       string
+	count      uint64
+	style      PlaceholderStyle
+	template   *queryTemplate
+	generators []generate.ValueGenerator
+}
+
+// NewQueryBuilder creates QueryBuilder for descriptor.
+// Every param generator is seeded with ParamSeed(seed, query name, param name).
+func NewQueryBuilder(
+	seed uint64,
+	descriptor *stroppy.QueryDescriptor,
+	style PlaceholderStyle,
+) (*QueryBuilder, error) {
+	return newQueryBuilder(seed, descriptor, style, descriptor.GetCount())
+}
+
+// newQueryBuilder creates QueryBuilder which generators expect totalSize values in sum,
+// it differs from descriptor count when query is repeated inside transactions.
+func newQueryBuilder(
+	seed uint64,
+	descriptor *stroppy.QueryDescriptor,
+	style PlaceholderStyle,
+	totalSize uint64,
+) (*QueryBuilder, error) {
+	template, err := newQueryTemplate(descriptor, style)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse query '%s': %w", descriptor.GetName(), err)
+	}
+
+	used := make(map[int]struct{}, len(template.args))
+	for _, param := range template.args {
+		used[param] = struct{}{}
+	}
+
+	generators := make([]generate.ValueGenerator, len(descriptor.GetParams()))
+
+	for idx, param := range descriptor.GetParams() {
+		if _, ok := used[idx]; !ok {
+			continue
+		}
+
+		gen, err := generate.NewValueGenerator(
+			ParamSeed(seed, descriptor.GetName(), param.GetName()),
+			totalSize,
+			param,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build query '%s': %w", descriptor.GetName(), err)
+		}
+
+		generators[idx] = gen
+	}
+
+	return &QueryBuilder{
+		name:       descriptor.GetName(),
+		count:      descriptor.GetCount(),
+		style:      style,
+		template:   template,
+		generators: generators,
+	}, nil
+}
+
+// Count returns the number of queries described by QueryDescriptor.Count.
+func (b *QueryBuilder) Count() uint64 {
+	return b.count
+}
+
+// SQL returns the query text rewritten to driver placeholders.
+func (b *QueryBuilder) SQL() string {
+	return b.template.sql
+}
+
+// Next generates the next query with fresh param values.
+func (b *QueryBuilder) Next() (*stroppy.DriverQuery, error) {
+	values := make([]*stroppy.Value, len(b.generators))
+
+	for idx, gen := range b.generators {
+		if gen == nil {
+			continue
+		}
+
+		value, err := gen.Next()
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate params for query '%s': %w", b.name, err)
+		}
+
+		values[idx] = value
+	}
+
+	params := make([]*stroppy.Value, 0, len(b.template.args))
+	for _, param := range b.template.args {
+		params = append(params, b.style.BindValue(values[param], b.template.names[param]))
+	}
+
+	return &stroppy.DriverQuery{
+		Name:    b.name,
+		Request: b.template.sql,
+		Params:  params,
+	}, nil
+}
+
+// Build generates all Count queries of the descriptor.
+func (b *QueryBuilder) Build() ([]*stroppy.DriverQuery, error) {
+	result := make([]*stroppy.DriverQuery, 0, b.count)
+
+	for range b.count {
+		query, err := b.Next()
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, query)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,224 @@
+package queries
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	stroppy "github.com/stroppy-io/stroppy-core/pkg/proto"
+)
+
+func int64Param(name, regex string, minVal, maxVal int64) *stroppy.QueryParamDescriptor {
+	return &stroppy.QueryParamDescriptor{
+		Name:         name,
+		ReplaceRegex: regex,
+		GenerationRule: &stroppy.Generation_Rule{
+			Type: &stroppy.Generation_Rule_Int64Rules{
+				Int64Rules: &stroppy.Generation_Rules_Int64Rule{
+					Range: &stroppy.Generation_Range_Int64Range{Min: minVal, Max: maxVal},
+				},
+			},
+			Distribution: &stroppy.Generation_Distribution{
+				Type: stroppy.Generation_Distribution_UNIFORM,
+			},
+		},
+	}
+}
+
+func TestNewQueryBuilder_Placeholders(t *testing.T) {
+	descriptor := &stroppy.QueryDescriptor{
+		Name:  "select",
+		Sql:   "SELECT * FROM t WHERE a = ${a} AND b = ${b} OR a > ${a}",
+		Count: 1,
+		Params: []*stroppy.QueryParamDescriptor{
+			int64Param("a", "", 1, 10),
+			int64Param("b", "", 1, 10),
+		},
+	}
+
+	tests := []struct {
+		name       string
+		style      PlaceholderStyle
+		wantSQL    string
+		wantParams int
+	}{
+		{
+			name:       "dollar",
+			style:      PlaceholderDollar,
+			wantSQL:    "SELECT * FROM t WHERE a = $1 AND b = $2 OR a > $1",
+			wantParams: 2,
+		},
+		{
+			name:       "question",
+			style:      PlaceholderQuestion,
+			wantSQL:    "SELECT * FROM t WHERE a = ? AND b = ? OR a > ?",
+			wantParams: 3,
+		},
+		{
+			name:       "named",
+			style:      PlaceholderNamed,
+			wantSQL:    "SELECT * FROM t WHERE a = @a AND b = @b OR a > @a",
+			wantParams: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			builder, err := NewQueryBuilder(42, descriptor, tt.style)
+			require.NoError(t, err)
+			require.Equal(t, tt.wantSQL, builder.SQL())
+
+			query, err := builder.Next()
+			require.NoError(t, err)
+			require.Equal(t, "select", query.GetName())
+			require.Equal(t, tt.wantSQL, query.GetRequest())
+			require.Len(t, query.GetParams(), tt.wantParams)
+		})
+	}
+}
+
+func TestNewQueryBuilder_NamedParams(t *testing.T) {
+	descriptor := &stroppy.QueryDescriptor{
+		Name:  "select",
+		Sql:   "SELECT ${a b}, ${a_b}, ${1st}, ${a b}",
+		Count: 1,
+		Params: []*stroppy.QueryParamDescriptor{
+			int64Param("a b", "", 1, 10),
+			int64Param("a_b", "", 1, 10),
+			int64Param("1st", "", 1, 10),
+		},
+	}
+
+	builder, err := NewQueryBuilder(42, descriptor, PlaceholderNamed)
+	require.NoError(t, err)
+	require.Equal(t, "SELECT @a_b, @a_b_2, @_1st, @a_b", builder.SQL())
+
+	query, err := builder.Next()
+	require.NoError(t, err)
+	require.Len(t, query.GetParams(), 3)
+
+	keys := make([]string, 0, len(query.GetParams()))
+
+	for _, param := range query.GetParams() {
+		keys = append(keys, param.GetKey())
+	}
+
+	require.Equal(t, []string{"a_b", "a_b_2", "_1st"}, keys)
+
+	positional, err := NewQueryBuilder(42, descriptor, PlaceholderDollar)
+	require.NoError(t, err)
+
+	query, err = positional.Next()
+	require.NoError(t, err)
+	require.Empty(t, query.GetParams()[0].GetKey())
+}
+
+func TestNewQueryBuilder_RepeatedPositionalParamHasSameValue(t *testing.T) {
+	descriptor := &stroppy.QueryDescriptor{
+		Name:   "select",
+		Sql:    "SELECT ${a}, ${a}",
+		Count:  1,
+		Params: []*stroppy.QueryParamDescriptor{int64Param("a", "", 1, 1000000)},
+	}
+
+	builder, err := NewQueryBuilder(1, descriptor, PlaceholderQuestion)
+	require.NoError(t, err)
+
+	query, err := builder.Next()
+	require.NoError(t, err)
+	require.Len(t, query.GetParams(), 2)
+	require.Equal(t, query.GetParams()[0].GetInt64(), query.GetParams()[1].GetInt64())
+}
+
+func TestNewQueryBuilder_CustomRegex(t *testing.T) {
+	descriptor := &stroppy.QueryDescriptor{
+		Name:   "insert",
+		Sql:    "INSERT INTO t VALUES (:id, :val)",
+		Count:  1,
+		Params: []*stroppy.QueryParamDescriptor{int64Param("id", `:id\b`, 1, 10), int64Param("val", `:val\b`, 1, 10)},
+	}
+
+	builder, err := NewQueryBuilder(1, descriptor, PlaceholderDollar)
+	require.NoError(t, err)
+	require.Equal(t, "INSERT INTO t VALUES ($1, $2)", builder.SQL())
+}
+
+func TestNewQueryBuilder_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  []*stroppy.QueryParamDescriptor
+		wantErr error
+	}{
+		{
+			name:   "invalid regex",
+			params: []*stroppy.QueryParamDescriptor{int64Param("a", "(", 1, 2)},
+		},
+		{
+			name:    "empty match",
+			params:  []*stroppy.QueryParamDescriptor{int64Param("a", "x*", 1, 2)},
+			wantErr: ErrEmptyParamMatch,
+		},
+		{
+			name: "overlapping matches",
+			params: []*stroppy.QueryParamDescriptor{
+				int64Param("a", `\$\{a\}`, 1, 2),
+				int64Param("b", `a\}`, 1, 2),
+			},
+			wantErr: ErrOverlappingParamMatch,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewQueryBuilder(1, &stroppy.QueryDescriptor{
+				Name:   "q",
+				Sql:    "SELECT ${a}",
+				Count:  1,
+				Params: tt.params,
+			}, PlaceholderDollar)
+			require.Error(t, err)
+
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestQueryBuilder_BuildIsDeterministic(t *testing.T) {
+	descriptor := &stroppy.QueryDescriptor{
+		Name:   "select",
+		Sql:    "SELECT ${a}, ${b}",
+		Count:  10,
+		Params: []*stroppy.QueryParamDescriptor{int64Param("a", "", 1, 1000000), int64Param("b", "", 1, 1000000)},
+	}
+
+	build := func(seed uint64) []*stroppy.DriverQuery {
+		builder, err := NewQueryBuilder(seed, descriptor, PlaceholderDollar)
+		require.NoError(t, err)
+		require.Equal(t, uint64(10), builder.Count())
+
+		queries, err := builder.Build()
+		require.NoError(t, err)
+		require.Len(t, queries, 10)
+
+		return queries
+	}
+
+	first, second, other := build(7), build(7), build(8)
+
+	for i := range first {
+		require.Equal(t, first[i].GetParams()[0].GetInt64(), second[i].GetParams()[0].GetInt64())
+		require.Equal(t, first[i].GetParams()[1].GetInt64(), second[i].GetParams()[1].GetInt64())
+	}
+
+	require.NotEqual(t, first[0].GetParams()[0].GetInt64(), other[0].GetParams()[0].GetInt64())
+	require.NotEqual(t, first[0].GetParams()[0].GetInt64(), first[0].GetParams()[1].GetInt64())
+}
+
+func TestParamSeed(t *testing.T) {
+	require.Equal(t, ParamSeed(1, "q", "a"), ParamSeed(1, "q", "a"))
+	require.NotEqual(t, ParamSeed(1, "q", "a"), ParamSeed(2, "q", "a"))
+	require.NotEqual(t, ParamSeed(1, "q", "a"), ParamSeed(1, "q", "b"))
+	require.NotEqual(t, ParamSeed(1, "qa", ""), ParamSeed(1, "q", "a"))
+}
@@ -0,0 +1,210 @@
+package queries
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/stroppy-io/stroppy-core/pkg/generate"
+	stroppy "github.com/stroppy-io/stroppy-core/pkg/proto"
+	"github.com/stroppy-io/stroppy-core/pkg/protovalue"
+)
+
+const DefaultInsertBatchSize = 1000
+
+const (
+	// MaxBindParams is the maximum number of numbered and anonymous bind parameters of a single query,
+	// it is the limit of SQLite which is the lowest one of their databases (PostgreSQL allows 65535).
+	MaxBindParams = 32766
+	// MaxNamedBindParams is the maximum number of named bind parameters of a single query,
+	// it is the limit of SQL Server.
+	MaxNamedBindParams = 2100
+)
+
+var ErrNoRowsLeft = errors.New("all rows of insert are already generated")
+
+// InsertBuilder generates rows of InsertDescriptor batch by batch.
+type InsertBuilder struct {
+	descriptor *stroppy.InsertDescriptor
+	columns    []string
+	generators []generate.ValueGenerator
+	batchSize  uint64
+	remaining  uint64
+}
+
+// NewInsertBuilder creates InsertBuilder for descriptor.
+// Every column generator is seeded with ParamSeed(seed, insert name, column name).
+func NewInsertBuilder(seed uint64, descriptor *stroppy.InsertDescriptor) (*InsertBuilder, error) {
+	columns := make([]string, 0, len(descriptor.GetColumns()))
+	generators := make([]generate.ValueGenerator, 0, len(descriptor.GetColumns()))
+
+	for _, column := range descriptor.GetColumns() {
+		gen, err := generate.NewValueGenerator(
+			ParamSeed(seed, descriptor.GetName(), column.GetName()),
+			descriptor.GetCount(),
+			column,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to build insert '%s': %w", descriptor.GetName(), err)
+		}
+
+		columns = append(columns, column.GetName())
+		generators = append(generators, gen)
+	}
+
+	batchSize := descriptor.GetBatchSize()
+	if batchSize == 0 {
+		batchSize = DefaultInsertBatchSize
+	}
+
+	return &InsertBuilder{
+		descriptor: descriptor,
+		columns:    columns,
+		generators: generators,
+		batchSize:  batchSize,
+		remaining:  descriptor.GetCount(),
+	}, nil
+}
+
+// Batches returns the total number of batches needed to insert all rows.
+func (b *InsertBuilder) Batches() uint64 {
+	return (b.descriptor.GetCount() + b.batchSize - 1) / b.batchSize
+}
+
+func (b *InsertBuilder) nextRow() (*stroppy.Value_List, error) {
+	row := &stroppy.Value_List{Values: make([]*stroppy.Value, 0, len(b.generators))}
+
+	for idx, gen := range b.generators {
+		value, err := gen.Next()
+		if err != nil {
+			return nil, fmt.Errorf(
+				"failed to generate column '%s' of insert '%s': %w",
+				b.columns[idx],
+				b.descriptor.GetName(),
+				err,
+			)
+		}
+
+		row.Values = append(row.Values, value)
+	}
+
+	return row, nil
+}
+
+// NextBatch generates the next batch of at most batch size rows.
+func (b *InsertBuilder) NextBatch() (*stroppy.DriverBulkInsert, error) {
+	if b.remaining == 0 {
+		return nil, ErrNoRowsLeft
+	}
+
+	size := min(b.batchSize, b.remaining)
+	rows := make([]*stroppy.Value_List, 0, size)
+
+	for range size {
+		row, err := b.nextRow()
+		if err != nil {
+			return nil, err
+		}
+
+		rows = append(rows, row)
+	}
+
+	b.remaining -= size
+
+	return &stroppy.DriverBulkInsert{
+		Name:       b.descriptor.GetName(),
+		TableName:  b.descriptor.GetTableName(),
+		Columns:    b.columns,
+		Rows:       rows,
+		Method:     b.descriptor.GetMethod(),
+		DbSpecific: b.descriptor.GetDbSpecific(),
+	}, nil
+}
+
+// RenderInsertQuery renders batch as a single multi-row INSERT ... VALUES query.
+// Named placeholders are column names made valid as in QueryBuilder and suffixed
+// with the 1-based row number to keep them unique.
+// The query binds rows × columns parameters, use RenderInsertQueries for batches
+// which may exceed the bind parameters limit of style.
+func RenderInsertQuery(batch *stroppy.DriverBulkInsert, style PlaceholderStyle) *stroppy.DriverQuery {
+	return renderInsertRows(batch, batch.GetRows(), style)
+}
+
+// RenderInsertQueries renders batch as multi-row INSERT ... VALUES queries
+// which bind at most style.MaxBindParams() parameters each.
+func RenderInsertQueries(batch *stroppy.DriverBulkInsert, style PlaceholderStyle) []*stroppy.DriverQuery {
+	rowsPerQuery := max(1, style.MaxBindParams()/max(1, len(batch.GetColumns())))
+	rows := batch.GetRows()
+	queries := make([]*stroppy.DriverQuery, 0, (len(rows)+rowsPerQuery-1)/rowsPerQuery)
+
+	for start := 0; start < len(rows); start += rowsPerQuery {
+		queries = append(queries, renderInsertRows(batch, rows[start:min(start+rowsPerQuery, len(rows))], style))
+	}
+
+	return queries
+}
+
+func renderInsertRows(
+	batch *stroppy.DriverBulkInsert,
+	rows []*stroppy.Value_List,
+	style PlaceholderStyle,
+) *stroppy.DriverQuery {
+	var sql strings.Builder
+
+	sql.WriteString("INSERT INTO ")
+	sql.WriteString(quoteIdent(batch.GetTableName()))
+	sql.WriteString(" (")
+	sql.WriteString(quoteIdents(batch.GetColumns()))
+	sql.WriteString(") VALUES ")
+
+	params := make([]*stroppy.Value, 0, len(rows)*len(batch.GetColumns()))
+	names := bindNames(batch.GetColumns())
+
+	for rowIdx, row := range rows {
+		if rowIdx > 0 {
+			sql.WriteString(", ")
+		}
+
+		sql.WriteString("(")
+
+		for colIdx, value := range row.GetValues() {
+			if colIdx > 0 {
+				sql.WriteString(", ")
+			}
+
+			name := fmt.Sprintf("%s_%d", names[colIdx], rowIdx+1)
+			params = append(params, style.BindValue(value, name))
+			sql.WriteString(style.Format(len(params), name))
+		}
+
+		sql.WriteString(")")
+	}
+
+	return &stroppy.DriverQuery{
+		Name:    batch.GetName(),
+		Request: sql.String(),
+		Params:  params,
+	}
+}
+
+// RenderCopyFrom renders the COPY statement which precedes streaming of batch rows.
+func RenderCopyFrom(batch *stroppy.DriverBulkInsert) string {
+	return "COPY " + quoteIdent(batch.GetTableName()) + " (" + quoteIdents(batch.GetColumns()) + ") FROM STDIN"
+}
+
+// InsertRows converts batch rows to plain Go values suitable for driver bulk APIs.
+// Values are converted with protovalue package rules.
+func InsertRows(batch *stroppy.DriverBulkInsert) ([][]any, error) {
+	rows := make([][]any, 0, len(batch.GetRows()))
+
+	for _, row := range batch.GetRows() {
+		values, err := protovalue.ValueListToSlice(row)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert rows of insert '%s': %w", batch.GetName(), err)
+		}
+
+		rows = append(rows, values)
+	}
+
+	return rows, nil
+}
@@ -0,0 +1,173 @@
+package queries
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	stroppy "github.com/stroppy-io/stroppy-core/pkg/proto"
+)
+
+func insertDescriptor(method stroppy.InsertMethod, count, batchSize uint64) *stroppy.InsertDescriptor {
+	return &stroppy.InsertDescriptor{
+		Name:      "fill_accounts",
+		TableName: "accounts",
+		Count:     count,
+		BatchSize: batchSize,
+		Method:    method,
+		Columns: []*stroppy.InsertColumnDescriptor{
+			{Name: "id", GenerationRule: int64Param("id", "", 1, 1000).GetGenerationRule()},
+			{Name: "balance", GenerationRule: int64Param("balance", "", 0, 100).GetGenerationRule()},
+		},
+	}
+}
+
+func TestInsertBuilder_Batches(t *testing.T) {
+	builder, err := NewInsertBuilder(1, insertDescriptor(stroppy.InsertMethod_INSERT_METHOD_COPY_FROM, 5, 2))
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), builder.Batches())
+
+	sizes := make([]int, 0)
+
+	for range builder.Batches() {
+		batch, err := builder.NextBatch()
+		require.NoError(t, err)
+		require.Equal(t, "accounts", batch.GetTableName())
+		require.Equal(t, []string{"id", "balance"}, batch.GetColumns())
+
+		sizes = append(sizes, len(batch.GetRows()))
+	}
+
+	require.Equal(t, []int{2, 2, 1}, sizes)
+
+	_, err = builder.NextBatch()
+	require.ErrorIs(t, err, ErrNoRowsLeft)
+}
+
+func TestInsertBuilder_DefaultBatchSize(t *testing.T) {
+	builder, err := NewInsertBuilder(1, insertDescriptor(stroppy.InsertMethod_INSERT_METHOD_BULK, DefaultInsertBatchSize+1, 0))
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), builder.Batches())
+}
+
+func TestRenderInsertQuery(t *testing.T) {
+	batch := &stroppy.DriverBulkInsert{
+		Name:      "fill",
+		TableName: "t",
+		Columns:   []string{"a", "b"},
+		Rows: []*stroppy.Value_List{
+			{Values: []*stroppy.Value{{Type: &stroppy.Value_Int64{Int64: 1}}, {Type: &stroppy.Value_Int64{Int64: 2}}}},
+			{Values: []*stroppy.Value{{Type: &stroppy.Value_Int64{Int64: 3}}, {Type: &stroppy.Value_Int64{Int64: 4}}}},
+		},
+	}
+
+	tests := []struct {
+		name  string
+		style PlaceholderStyle
+		want  string
+	}{
+		{"dollar", PlaceholderDollar, `INSERT INTO "t" ("a", "b") VALUES ($1, $2), ($3, $4)`},
+		{"question", PlaceholderQuestion, `INSERT INTO "t" ("a", "b") VALUES (?, ?), (?, ?)`},
+		{"named", PlaceholderNamed, `INSERT INTO "t" ("a", "b") VALUES (@a_1, @b_1), (@a_2, @b_2)`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query := RenderInsertQuery(batch, tt.style)
+			require.Equal(t, tt.want, query.GetRequest())
+			require.Len(t, query.GetParams(), 4)
+			require.Equal(t, int64(3), query.GetParams()[2].GetInt64())
+		})
+	}
+}
+
+func TestRenderInsertQuery_NamedParams(t *testing.T) {
+	value := &stroppy.Value{Type: &stroppy.Value_Int64{Int64: 1}}
+	batch := &stroppy.DriverBulkInsert{
+		TableName: "t",
+		Columns:   []string{"first name", "id"},
+		Rows:      []*stroppy.Value_List{{Values: []*stroppy.Value{value, value}}},
+	}
+
+	query := RenderInsertQuery(batch, PlaceholderNamed)
+	require.Equal(t, `INSERT INTO "t" ("first name", "id") VALUES (@first_name_1, @id_1)`, query.GetRequest())
+	require.Equal(t, "first_name_1", query.GetParams()[0].GetKey())
+	require.Equal(t, "id_1", query.GetParams()[1].GetKey())
+	require.Equal(t, int64(1), query.GetParams()[1].GetInt64())
+	require.Empty(t, value.GetKey())
+}
+
+func TestRenderInsertQueries(t *testing.T) {
+	columns := []string{"a", "b", "c"}
+	rowsPerQuery := MaxBindParams / len(columns)
+	batch := &stroppy.DriverBulkInsert{TableName: "t", Columns: columns}
+
+	for idx := range 2*rowsPerQuery + 1 {
+		value := &stroppy.Value{Type: &stroppy.Value_Int64{Int64: int64(idx)}}
+		batch.Rows = append(batch.Rows, &stroppy.Value_List{Values: []*stroppy.Value{value, value, value}})
+	}
+
+	queries := RenderInsertQueries(batch, PlaceholderDollar)
+	require.Len(t, queries, 3)
+	require.Len(t, queries[0].GetParams(), rowsPerQuery*len(columns))
+	require.Len(t, queries[2].GetParams(), len(columns))
+	require.Equal(t, int64(2*rowsPerQuery), queries[2].GetParams()[0].GetInt64())
+	require.Equal(t, `INSERT INTO "t" ("a", "b", "c") VALUES ($1, $2, $3)`, queries[2].GetRequest())
+
+	namedRowsPerQuery := MaxNamedBindParams / len(columns)
+	queries = RenderInsertQueries(batch, PlaceholderNamed)
+	require.Len(t, queries, (len(batch.GetRows())+namedRowsPerQuery-1)/namedRowsPerQuery)
+	require.Len(t, queries[0].GetParams(), namedRowsPerQuery*len(columns))
+}
+
+func TestRenderCopyFrom(t *testing.T) {
+	batch := &stroppy.DriverBulkInsert{TableName: `my "t"`, Columns: []string{"a", "b c"}}
+
+	require.Equal(t, `COPY "my ""t""" ("a", "b c") FROM STDIN`, RenderCopyFrom(batch))
+}
+
+func TestInsertRows(t *testing.T) {
+	batch := &stroppy.DriverBulkInsert{
+		Columns: []string{"a", "b"},
+		Rows: []*stroppy.Value_List{
+			{Values: []*stroppy.Value{{Type: &stroppy.Value_Int64{Int64: 1}}, {Type: &stroppy.Value_String_{String_: "x"}}}},
+		},
+	}
+
+	rows, err := InsertRows(batch)
+	require.NoError(t, err)
+	require.Equal(t, [][]any{{int64(1), "x"}}, rows)
+}
+
+func TestTransactionBuilder_InsertUnit(t *testing.T) {
+	builder := NewTransactionBuilder(PlaceholderDollar)
+
+	for _, tt := range []struct {
+		name   string
+		method stroppy.InsertMethod
+	}{
+		{"plain query", stroppy.InsertMethod_INSERT_METHOD_PLAIN_QUERY},
+		{"copy from", stroppy.InsertMethod_INSERT_METHOD_COPY_FROM},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			unit := &stroppy.StepUnitDescriptor{
+				Type: &stroppy.StepUnitDescriptor_Insert{Insert: insertDescriptor(tt.method, 10, 4)},
+			}
+
+			result, err := builder.BuildTransactionsFromUnit(context.Background(), newUnitBuildContext(1, unit))
+			require.NoError(t, err)
+			require.Len(t, result.GetTransactions(), 3)
+
+			for _, transaction := range result.GetTransactions() {
+				if tt.method == stroppy.InsertMethod_INSERT_METHOD_PLAIN_QUERY {
+					require.Len(t, transaction.GetQueries(), 1)
+					require.Nil(t, transaction.GetBulkInsert())
+				} else {
+					require.Empty(t, transaction.GetQueries())
+					require.NotNil(t, transaction.GetBulkInsert())
+				}
+			}
+		})
+	}
+}
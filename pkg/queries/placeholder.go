@@ -0,0 +1,95 @@
+package queries
+
+import (
+	"regexp"
+	"strconv"
+
+	stroppy "github.com/stroppy-io/stroppy-core/pkg/proto"
+)
+
+// PlaceholderStyle defines how bind parameters are rendered in SQL for a concrete driver.
+type PlaceholderStyle int
+
+const (
+	// PlaceholderDollar renders numbered placeholders: $1, $2 (PostgreSQL).
+	PlaceholderDollar PlaceholderStyle = iota
+	// PlaceholderQuestion renders anonymous placeholders: ? (MySQL, SQLite).
+	PlaceholderQuestion
+	// PlaceholderNamed renders named placeholders: @name (SQL Server, Spanner).
+	// Every param carries its bind name in Value.Key.
+	PlaceholderNamed
+)
+
+// Format returns placeholder text for the parameter with the given 1-based position and name.
+func (s PlaceholderStyle) Format(position int, name string) string {
+	switch s {
+	case PlaceholderQuestion:
+		return "?"
+	case PlaceholderNamed:
+		return "@" + name
+	case PlaceholderDollar:
+		return "$" + strconv.Itoa(position)
+	default:
+		return "$" + strconv.Itoa(position)
+	}
+}
+
+// Positional reports whether every placeholder occurrence consumes its own argument.
+// Numbered and named placeholders may be reused, so a parameter is bound only once.
+func (s PlaceholderStyle) Positional() bool {
+	return s == PlaceholderQuestion
+}
+
+// Named reports whether placeholders refer to params by name, see BindValue.
+func (s PlaceholderStyle) Named() bool {
+	return s == PlaceholderNamed
+}
+
+// MaxBindParams returns the maximum number of bind parameters of a single query with placeholders of style.
+func (s PlaceholderStyle) MaxBindParams() int {
+	if s.Named() {
+		return MaxNamedBindParams
+	}
+
+	return MaxBindParams
+}
+
+var invalidBindNameChars = regexp.MustCompile(`[^A-Za-z0-9_]`) //nolint: gochecknoglobals // compiled once
+
+// bindNames returns valid and unique names of named placeholders for names: characters other than
+// ASCII letters, digits and underscores are replaced with underscores, a leading digit is prefixed
+// with an underscore, and names which become equal to a previous one are suffixed with their 1-based index.
+func bindNames(names []string) []string {
+	result := make([]string, 0, len(names))
+	taken := make(map[string]struct{}, len(names))
+
+	for idx, name := range names {
+		bindName := invalidBindNameChars.ReplaceAllString(name, "_")
+		if bindName == "" || (bindName[0] >= '0' && bindName[0] <= '9') {
+			bindName = "_" + bindName
+		}
+
+		for {
+			if _, ok := taken[bindName]; !ok {
+				break
+			}
+
+			bindName += "_" + strconv.Itoa(idx+1)
+		}
+
+		taken[bindName] = struct{}{}
+		result = append(result, bindName)
+	}
+
+	return result
+}
+
+// BindValue returns value with its bind name in Value.Key for named placeholder styles and value itself otherwise.
+// The returned value shares the oneof type with value.
+func (s PlaceholderStyle) BindValue(value *stroppy.Value, name string) *stroppy.Value {
+	if !s.Named() {
+		return value
+	}
+
+	return &stroppy.Value{Type: value.GetType(), Key: name}
+}
@@ -0,0 +1,90 @@
+package queries
+
+import (
+	"fmt"
+	"strings"
+
+	stroppy "github.com/stroppy-io/stroppy-core/pkg/proto"
+)
+
+func quoteIdent(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
+
+func quoteIdents(names []string) string {
+	quoted := make([]string, 0, len(names))
+	for _, name := range names {
+		quoted = append(quoted, quoteIdent(name))
+	}
+
+	return strings.Join(quoted, ", ")
+}
+
+// RenderCreateTable renders the table and its indexes as a transaction of standard SQL DDL queries
+// with double-quoted identifiers. Tables and indexes are created only if they do not exist,
+// so steps may be rerun.
+func RenderCreateTable(table *stroppy.TableDescriptor) *stroppy.DriverTransaction {
+	definitions := make([]string, 0, len(table.GetColumns())+2)
+	primaryKey := make([]string, 0, 1)
+
+	for _, column := range table.GetColumns() {
+		definition := quoteIdent(column.GetName()) + " " + column.GetSqlType()
+
+		if !column.GetNullable() {
+			definition += " NOT NULL"
+		}
+
+		if column.GetUnique() {
+			definition += " UNIQUE"
+		}
+
+		if column.GetConstraint() != "" {
+			definition += " " + column.GetConstraint()
+		}
+
+		if column.GetPrimaryKey() {
+			primaryKey = append(primaryKey, column.GetName())
+		}
+
+		definitions = append(definitions, definition)
+	}
+
+	if len(primaryKey) > 0 {
+		definitions = append(definitions, "PRIMARY KEY ("+quoteIdents(primaryKey)+")")
+	}
+
+	if table.GetConstraint() != "" {
+		definitions = append(definitions, table.GetConstraint())
+	}
+
+	transaction := &stroppy.DriverTransaction{
+		Queries: []*stroppy.DriverQuery{{
+			Name: table.GetName(),
+			Request: fmt.Sprintf(
+				"CREATE TABLE IF NOT EXISTS %s (%s)",
+				quoteIdent(table.GetName()),
+				strings.Join(definitions, ", "),
+			),
+		}},
+	}
+
+	for _, index := range table.GetTableIndexes() {
+		unique := ""
+		if index.GetUnique() {
+			unique = "UNIQUE "
+		}
+
+		transaction.Queries = append(transaction.Queries, &stroppy.DriverQuery{
+			Name: index.GetName(),
+			Request: fmt.Sprintf(
+				"CREATE %sINDEX IF NOT EXISTS %s ON %s (%s)",
+				unique,
+				quoteIdent(index.GetName()),
+				quoteIdent(table.GetName()),
+				quoteIdents(index.GetColumns()),
+			),
+		})
+	}
+
+	return transaction
+}
@@ -0,0 +1,30 @@
+package queries
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	stroppy "github.com/stroppy-io/stroppy-core/pkg/proto"
+)
+
+func TestRenderCreateTable(t *testing.T) {
+	transaction := RenderCreateTable(&stroppy.TableDescriptor{
+		Name: "accounts",
+		Columns: []*stroppy.ColumnDescriptor{
+			{Name: "id", SqlType: "INTEGER", PrimaryKey: true},
+			{Name: "email", SqlType: "TEXT", Unique: true},
+			{Name: "balance", SqlType: "NUMERIC", Nullable: true, Constraint: "CHECK (balance >= 0)"},
+		},
+		TableIndexes: []*stroppy.IndexDescriptor{{Name: "accounts_balance", Columns: []string{"balance"}}},
+	})
+
+	require.Len(t, transaction.GetQueries(), 2)
+	require.Equal(t,
+		`CREATE TABLE IF NOT EXISTS "accounts" ("id" INTEGER NOT NULL, "email" TEXT NOT NULL UNIQUE, `+
+			`"balance" NUMERIC CHECK (balance >= 0), PRIMARY KEY ("id"))`,
+		transaction.GetQueries()[0].GetRequest())
+	require.Equal(t,
+		`CREATE INDEX IF NOT EXISTS "accounts_balance" ON "accounts" ("balance")`,
+		transaction.GetQueries()[1].GetRequest())
+}
@@ -0,0 +1,208 @@
+package queries
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	stroppy "github.com/stroppy-io/stroppy-core/pkg/proto"
+	"github.com/stroppy-io/stroppy-core/pkg/utils/errchan"
+)
+
+var ErrUnsupportedUnitType = errors.New("unsupported unit type")
+
+// TransactionBuilder turns create table, query, transaction and insert units into DriverTransactions.
+// Create table units are rendered with RenderCreateTable.
+// Insert batches become multi-row INSERT queries within the bind parameters limit for INSERT_METHOD_PLAIN_QUERY
+// and DriverTransaction.BulkInsert for the other methods.
+// Drivers may embed it to get BuildTransactionsFromUnit and BuildTransactionsFromUnitStream
+// of driver.Plugin and implement only the execution part.
+type TransactionBuilder struct {
+	style PlaceholderStyle
+}
+
+func NewTransactionBuilder(style PlaceholderStyle) *TransactionBuilder {
+	return &TransactionBuilder{style: style}
+}
+
+// txSource yields transactions of a single unit one by one.
+type txSource struct {
+	count uint64
+	next  func() (*stroppy.DriverTransaction, error)
+}
+
+func (b *TransactionBuilder) newQuerySource(
+	seed uint64,
+	descriptor *stroppy.QueryDescriptor,
+) (*txSource, error) {
+	builder, err := NewQueryBuilder(seed, descriptor, b.style)
+	if err != nil {
+		return nil, err
+	}
+
+	return &txSource{
+		count: builder.Count(),
+		next: func() (*stroppy.DriverTransaction, error) {
+			query, err := builder.Next()
+			if err != nil {
+				return nil, err
+			}
+
+			return &stroppy.DriverTransaction{Queries: []*stroppy.DriverQuery{query}}, nil
+		},
+	}, nil
+}
+
+func (b *TransactionBuilder) newTransactionSource(
+	seed uint64,
+	descriptor *stroppy.TransactionDescriptor,
+) (*txSource, error) {
+	txSeed := ParamSeed(seed, descriptor.GetName())
+	builders := make([]*QueryBuilder, 0, len(descriptor.GetQueries()))
+
+	for _, query := range descriptor.GetQueries() {
+		builder, err := newQueryBuilder(txSeed, query, b.style, query.GetCount()*descriptor.GetCount())
+		if err != nil {
+			return nil, fmt.Errorf("failed to build transaction '%s': %w", descriptor.GetName(), err)
+		}
+
+		builders = append(builders, builder)
+	}
+
+	return &txSource{
+		count: descriptor.GetCount(),
+		next: func() (*stroppy.DriverTransaction, error) {
+			transaction := &stroppy.DriverTransaction{
+				IsolationLevel: descriptor.GetIsolationLevel(),
+				Queries:        make([]*stroppy.DriverQuery, 0, len(builders)),
+			}
+
+			for _, builder := range builders {
+				queries, err := builder.Build()
+				if err != nil {
+					return nil, fmt.Errorf("failed to build transaction '%s': %w", descriptor.GetName(), err)
+				}
+
+				transaction.Queries = append(transaction.Queries, queries...)
+			}
+
+			return transaction, nil
+		},
+	}, nil
+}
+
+func (b *TransactionBuilder) newInsertSource(
+	seed uint64,
+	descriptor *stroppy.InsertDescriptor,
+) (*txSource, error) {
+	builder, err := NewInsertBuilder(seed, descriptor)
+	if err != nil {
+		return nil, err
+	}
+
+	return &txSource{
+		count: builder.Batches(),
+		next: func() (*stroppy.DriverTransaction, error) {
+			batch, err := builder.NextBatch()
+			if err != nil {
+				return nil, err
+			}
+
+			if batch.GetMethod() == stroppy.InsertMethod_INSERT_METHOD_PLAIN_QUERY {
+				return &stroppy.DriverTransaction{
+					Queries: RenderInsertQueries(batch, b.style),
+				}, nil
+			}
+
+			return &stroppy.DriverTransaction{BulkInsert: batch}, nil
+		},
+	}, nil
+}
+
+// UnitTypes returns capabilities unit types of units the builder supports.
+func (b *TransactionBuilder) UnitTypes() []stroppy.DriverCapabilities_UnitType {
+	return []stroppy.DriverCapabilities_UnitType{
+		stroppy.DriverCapabilities_UNIT_TYPE_CREATE_TABLE,
+		stroppy.DriverCapabilities_UNIT_TYPE_QUERY,
+		stroppy.DriverCapabilities_UNIT_TYPE_TRANSACTION,
+		stroppy.DriverCapabilities_UNIT_TYPE_INSERT,
+	}
+}
+
+func newCreateTableSource(descriptor *stroppy.TableDescriptor) *txSource {
+	return &txSource{
+		count: 1,
+		next: func() (*stroppy.DriverTransaction, error) {
+			return RenderCreateTable(descriptor), nil
+		},
+	}
+}
+
+func (b *TransactionBuilder) newSource(buildUnitContext *stroppy.UnitBuildContext) (*txSource, error) {
+	seed := buildUnitContext.GetContext().GetGlobalConfig().GetRun().GetSeed()
+
+	switch unit := buildUnitContext.GetUnit().GetType().(type) {
+	case *stroppy.StepUnitDescriptor_CreateTable:
+		return newCreateTableSource(unit.CreateTable), nil
+	case *stroppy.StepUnitDescriptor_Query:
+		return b.newQuerySource(seed, unit.Query)
+	case *stroppy.StepUnitDescriptor_Transaction:
+		return b.newTransactionSource(seed, unit.Transaction)
+	case *stroppy.StepUnitDescriptor_Insert:
+		return b.newInsertSource(seed, unit.Insert)
+	default:
+		return nil, fmt.Errorf("%w: %T", ErrUnsupportedUnitType, unit)
+	}
+}
+
+// BuildTransactionsFromUnit builds all transactions of the unit at once.
+func (b *TransactionBuilder) BuildTransactionsFromUnit(
+	_ context.Context,
+	buildUnitContext *stroppy.UnitBuildContext,
+) (*stroppy.DriverTransactionList, error) {
+	source, err := b.newSource(buildUnitContext)
+	if err != nil {
+		return nil, err
+	}
+
+	transactions := make([]*stroppy.DriverTransaction, 0, source.count)
+
+	for range source.count {
+		transaction, err := source.next()
+		if err != nil {
+			return nil, err
+		}
+
+		transactions = append(transactions, transaction)
+	}
+
+	return &stroppy.DriverTransactionList{Transactions: transactions}, nil
+}
+
+// BuildTransactionsFromUnitStream builds transactions lazily: the next transaction
+// is generated only after the previous one was received from the channel.
+// Generation stops when ctx is done.
+func (b *TransactionBuilder) BuildTransactionsFromUnitStream(
+	ctx context.Context,
+	buildUnitContext *stroppy.UnitBuildContext,
+) (errchan.Chan[stroppy.DriverTransaction], error) {
+	source, err := b.newSource(buildUnitContext)
+	if err != nil {
+		return nil, err
+	}
+
+	channel := make(errchan.Chan[stroppy.DriverTransaction])
+
+	go func() {
+		defer errchan.Close[stroppy.DriverTransaction](channel)
+
+		for range source.count {
+			transaction, err := source.next()
+			if sendErr := errchan.SendCtx(ctx, channel, transaction, err); sendErr != nil || err != nil {
+				return
+			}
+		}
+	}()
+
+	return channel, nil
+}
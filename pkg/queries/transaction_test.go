@@ -0,0 +1,168 @@
+package queries
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	stroppy "github.com/stroppy-io/stroppy-core/pkg/proto"
+	"github.com/stroppy-io/stroppy-core/pkg/utils/errchan"
+)
+
+func newUnitBuildContext(seed uint64, unit *stroppy.StepUnitDescriptor) *stroppy.UnitBuildContext {
+	return &stroppy.UnitBuildContext{
+		Context: &stroppy.StepContext{
+			GlobalConfig: &stroppy.Config{
+				Run: &stroppy.RunConfig{Seed: seed},
+			},
+		},
+		Unit: unit,
+	}
+}
+
+func transactionUnit() *stroppy.StepUnitDescriptor {
+	return &stroppy.StepUnitDescriptor{
+		Type: &stroppy.StepUnitDescriptor_Transaction{
+			Transaction: &stroppy.TransactionDescriptor{
+				Name:           "transfer",
+				IsolationLevel: stroppy.TxIsolationLevel_TX_ISOLATION_LEVEL_SERIALIZABLE,
+				Count:          3,
+				Queries: []*stroppy.QueryDescriptor{
+					{
+						Name:   "debit",
+						Sql:    "UPDATE acc SET v = v - 1 WHERE id = ${id}",
+						Count:  2,
+						Params: []*stroppy.QueryParamDescriptor{int64Param("id", "", 1, 1000)},
+					},
+					{
+						Name:   "credit",
+						Sql:    "UPDATE acc SET v = v + 1 WHERE id = ${id}",
+						Count:  1,
+						Params: []*stroppy.QueryParamDescriptor{int64Param("id", "", 1, 1000)},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestTransactionBuilder_BuildTransactionsFromUnit(t *testing.T) {
+	builder := NewTransactionBuilder(PlaceholderDollar)
+
+	result, err := builder.BuildTransactionsFromUnit(context.Background(), newUnitBuildContext(1, transactionUnit()))
+	require.NoError(t, err)
+	require.Len(t, result.GetTransactions(), 3)
+
+	for _, transaction := range result.GetTransactions() {
+		require.Equal(t, stroppy.TxIsolationLevel_TX_ISOLATION_LEVEL_SERIALIZABLE, transaction.GetIsolationLevel())
+		require.Len(t, transaction.GetQueries(), 3)
+		require.Equal(t, "debit", transaction.GetQueries()[0].GetName())
+		require.Equal(t, "debit", transaction.GetQueries()[1].GetName())
+		require.Equal(t, "credit", transaction.GetQueries()[2].GetName())
+		require.Equal(t, "UPDATE acc SET v = v + 1 WHERE id = $1", transaction.GetQueries()[2].GetRequest())
+	}
+}
+
+func TestTransactionBuilder_QueryUnit(t *testing.T) {
+	builder := NewTransactionBuilder(PlaceholderQuestion)
+	unit := &stroppy.StepUnitDescriptor{
+		Type: &stroppy.StepUnitDescriptor_Query{
+			Query: &stroppy.QueryDescriptor{
+				Name:   "select",
+				Sql:    "SELECT ${id}",
+				Count:  5,
+				Params: []*stroppy.QueryParamDescriptor{int64Param("id", "", 1, 10)},
+			},
+		},
+	}
+
+	result, err := builder.BuildTransactionsFromUnit(context.Background(), newUnitBuildContext(1, unit))
+	require.NoError(t, err)
+	require.Len(t, result.GetTransactions(), 5)
+
+	for _, transaction := range result.GetTransactions() {
+		require.Len(t, transaction.GetQueries(), 1)
+		require.Equal(t, "SELECT ?", transaction.GetQueries()[0].GetRequest())
+	}
+}
+
+func TestTransactionBuilder_CreateTable(t *testing.T) {
+	builder := NewTransactionBuilder(PlaceholderDollar)
+	table := &stroppy.TableDescriptor{
+		Name:    "t",
+		Columns: []*stroppy.ColumnDescriptor{{Name: "id", SqlType: "INTEGER", PrimaryKey: true}},
+	}
+	unit := &stroppy.StepUnitDescriptor{Type: &stroppy.StepUnitDescriptor_CreateTable{CreateTable: table}}
+
+	list, err := builder.BuildTransactionsFromUnit(context.Background(), newUnitBuildContext(1, unit))
+	require.NoError(t, err)
+	require.Len(t, list.GetTransactions(), 1)
+	require.True(t, proto.Equal(RenderCreateTable(table), list.GetTransactions()[0]))
+
+	stream, err := builder.BuildTransactionsFromUnitStream(context.Background(), newUnitBuildContext(1, unit))
+	require.NoError(t, err)
+
+	streamed, err := errchan.Collect(stream)
+	require.NoError(t, err)
+	require.Len(t, streamed, 1)
+	require.True(t, proto.Equal(RenderCreateTable(table), streamed[0]))
+}
+
+func TestTransactionBuilder_UnsupportedUnit(t *testing.T) {
+	builder := NewTransactionBuilder(PlaceholderDollar)
+	unit := &stroppy.StepUnitDescriptor{}
+
+	_, err := builder.BuildTransactionsFromUnit(context.Background(), newUnitBuildContext(1, unit))
+	require.ErrorIs(t, err, ErrUnsupportedUnitType)
+
+	_, err = builder.BuildTransactionsFromUnitStream(context.Background(), newUnitBuildContext(1, unit))
+	require.ErrorIs(t, err, ErrUnsupportedUnitType)
+}
+
+func TestTransactionBuilder_StreamMatchesList(t *testing.T) {
+	builder := NewTransactionBuilder(PlaceholderDollar)
+	ctx := context.Background()
+
+	list, err := builder.BuildTransactionsFromUnit(ctx, newUnitBuildContext(7, transactionUnit()))
+	require.NoError(t, err)
+
+	stream, err := builder.BuildTransactionsFromUnitStream(ctx, newUnitBuildContext(7, transactionUnit()))
+	require.NoError(t, err)
+
+	streamed, err := errchan.Collect[stroppy.DriverTransaction](stream)
+	require.NoError(t, err)
+	require.Len(t, streamed, len(list.GetTransactions()))
+
+	for i, transaction := range streamed {
+		require.True(t, proto.Equal(list.GetTransactions()[i], transaction))
+	}
+}
+
+func TestTransactionBuilder_StreamStopsOnCancel(t *testing.T) {
+	const count = 1_000_000
+
+	builder := NewTransactionBuilder(PlaceholderDollar)
+	unit := &stroppy.StepUnitDescriptor{
+		Type: &stroppy.StepUnitDescriptor_Query{
+			Query: &stroppy.QueryDescriptor{Name: "select", Sql: "SELECT 1", Count: count},
+		},
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+
+	stream, err := builder.BuildTransactionsFromUnitStream(ctx, newUnitBuildContext(1, unit))
+	require.NoError(t, err)
+
+	_, err = errchan.Receive[stroppy.DriverTransaction](stream)
+	require.NoError(t, err)
+
+	cancel()
+
+	received := 1
+	for range stream {
+		received++
+	}
+
+	require.Less(t, received, count)
+}
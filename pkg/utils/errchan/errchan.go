@@ -32,6 +32,15 @@ func Send[T any](ch Chan[T], data *T, err error) {
 	ch <- &ChanResult[T]{data: data, Error: err}
 }
 
+func SendCtx[T any](ctx context.Context, ch Chan[T], data *T, err error) error {
+	select {
+	case ch <- &ChanResult[T]{data: data, Error: err}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
 func Close[T any](ch Chan[T]) {
 	close(ch)
 }
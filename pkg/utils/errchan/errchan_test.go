@@ -1,6 +1,7 @@
 package errchan
 
 import (
+	"context"
 	"errors"
 	"testing"
 
@@ -146,6 +147,26 @@ func TestSend_WithError(t *testing.T) {
 	require.Equal(t, testError.Error(), result.Error.Error())
 }
 
+func TestSendCtx(t *testing.T) {
+	ch := make(Chan[string], 1)
+
+	err := SendCtx(context.Background(), ch, stringPtr("test"), nil)
+	require.NoError(t, err)
+
+	result := <-ch
+	require.Equal(t, "test", *result.data)
+}
+
+func TestSendCtx_Canceled(t *testing.T) {
+	ch := make(Chan[string])
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := SendCtx(ctx, ch, stringPtr("test"), nil)
+	require.ErrorIs(t, err, context.Canceled)
+}
+
 func TestClose(t *testing.T) {
 	ch := make(Chan[string], 1)
 
@@ -0,0 +1,247 @@
+package validation
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	stroppy "github.com/stroppy-io/stroppy-core/pkg/proto"
+)
+
+func (v *validator) rule(path string, rule *stroppy.Generation_Rule, count uint64, mustBeUnique bool) {
+	if !v.ranges(path, rule) {
+		return
+	}
+
+	if mustBeUnique && !rule.GetUnique() && isSequential(rule) && !hasConstant(rule) {
+		v.addf(path+".unique", "column must be unique, but rule may generate duplicates")
+	}
+
+	if !mustBeUnique && !rule.GetUnique() {
+		return
+	}
+
+	if distinct, bounded := distinctValues(rule); bounded && distinct < count {
+		v.addf(path, "rule yields at most %d distinct values, but %d are required", distinct, count)
+	}
+}
+
+// ranges reports problems of every range of rule and returns false if any was found.
+func (v *validator) ranges(path string, rule *stroppy.Generation_Rule) bool {
+	switch rule.GetType().(type) {
+	case *stroppy.Generation_Rule_FloatRules:
+		r := rule.GetFloatRules().GetRange()
+
+		return checkRange(v, path+".float_rules.range", r.GetMin(), r.GetMax())
+	case *stroppy.Generation_Rule_DoubleRules:
+		r := rule.GetDoubleRules().GetRange()
+
+		return checkRange(v, path+".double_rules.range", r.GetMin(), r.GetMax())
+	case *stroppy.Generation_Rule_Int32Rules:
+		r := rule.GetInt32Rules().GetRange()
+
+		return checkRange(v, path+".int32_rules.range", r.GetMin(), r.GetMax())
+	case *stroppy.Generation_Rule_Int64Rules:
+		r := rule.GetInt64Rules().GetRange()
+
+		return checkRange(v, path+".int64_rules.range", r.GetMin(), r.GetMax())
+	case *stroppy.Generation_Rule_Uint32Rules:
+		r := rule.GetUint32Rules().GetRange()
+
+		return checkRange(v, path+".uint32_rules.range", r.GetMin(), r.GetMax())
+	case *stroppy.Generation_Rule_Uint64Rules:
+		r := rule.GetUint64Rules().GetRange()
+
+		return checkRange(v, path+".uint64_rules.range", r.GetMin(), r.GetMax())
+	case *stroppy.Generation_Rule_StringRules:
+		return v.stringRanges(path+".string_rules", rule.GetStringRules())
+	case *stroppy.Generation_Rule_DatetimeRules:
+		minTime, maxTime, err := dateTimeBounds(rule.GetDatetimeRules().GetRange())
+		if err != nil {
+			v.addf(path+".datetime_rules.range", "%s", err)
+
+			return false
+		}
+
+		return checkRange(v, path+".datetime_rules.range", minTime.Unix(), maxTime.Unix())
+	case *stroppy.Generation_Rule_DecimalRules:
+		minDec, maxDec, err := decimalBounds(rule.GetDecimalRules().GetRange())
+		if err != nil {
+			v.addf(path+".decimal_rules.range", "%s", err)
+
+			return false
+		}
+
+		if minDec.GreaterThan(maxDec) {
+			v.addf(path+".decimal_rules.range", "min %s is greater than max %s", minDec, maxDec)
+
+			return false
+		}
+	}
+
+	return true
+}
+
+func (v *validator) stringRanges(path string, rule *stroppy.Generation_Rules_StringRule) bool {
+	valid := checkRange(v, path+".len_range", rule.GetLenRange().GetMin(), rule.GetLenRange().GetMax())
+
+	for idx, r := range rule.GetAlphabet().GetRanges() {
+		valid = checkRange(v, fmt.Sprintf("%s.alphabet.ranges[%d]", path, idx), r.GetMin(), r.GetMax()) && valid
+	}
+
+	return valid
+}
+
+func checkRange[T cmp.Ordered](v *validator, path string, minValue, maxValue T) bool {
+	if minValue > maxValue {
+		v.addf(path, "min %v is greater than max %v", minValue, maxValue)
+
+		return false
+	}
+
+	return true
+}
+
+func dateTimeBounds(ranges *stroppy.Generation_Range_DateTimeRange) (time.Time, time.Time, error) {
+	switch ranges.GetType().(type) {
+	case *stroppy.Generation_Range_DateTimeRange_Default_:
+		return ranges.GetDefault().GetMin().GetValue().AsTime(), ranges.GetDefault().GetMax().GetValue().AsTime(), nil
+	case *stroppy.Generation_Range_DateTimeRange_String_:
+		minTime, err := time.Parse(time.RFC3339, ranges.GetString_().GetMin())
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("failed to parse min: %w", err)
+		}
+
+		maxTime, err := time.Parse(time.RFC3339, ranges.GetString_().GetMax())
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("failed to parse max: %w", err)
+		}
+
+		return minTime, maxTime, nil
+	case *stroppy.Generation_Range_DateTimeRange_TimestampPb_:
+		return ranges.GetTimestampPb().GetMin().AsTime(), ranges.GetTimestampPb().GetMax().AsTime(), nil
+	default:
+		return time.Unix(int64(ranges.GetTimestamp().GetMin()), 0),
+			time.Unix(int64(ranges.GetTimestamp().GetMax()), 0),
+			nil
+	}
+}
+
+func decimalBounds(ranges *stroppy.Generation_Range_DecimalRange) (decimal.Decimal, decimal.Decimal, error) {
+	var minString, maxString string
+
+	switch ranges.GetType().(type) {
+	case *stroppy.Generation_Range_DecimalRange_Float:
+		return decimal.NewFromFloat32(ranges.GetFloat().GetMin()), decimal.NewFromFloat32(ranges.GetFloat().GetMax()), nil
+	case *stroppy.Generation_Range_DecimalRange_Double:
+		return decimal.NewFromFloat(ranges.GetDouble().GetMin()), decimal.NewFromFloat(ranges.GetDouble().GetMax()), nil
+	case *stroppy.Generation_Range_DecimalRange_String_:
+		minString, maxString = ranges.GetString_().GetMin(), ranges.GetString_().GetMax()
+	default:
+		minString, maxString = ranges.GetDefault().GetMin().GetValue(), ranges.GetDefault().GetMax().GetValue()
+	}
+
+	minDec, err := decimal.NewFromString(minString)
+	if err != nil {
+		return decimal.Decimal{}, decimal.Decimal{}, fmt.Errorf("failed to parse min: %w", err)
+	}
+
+	maxDec, err := decimal.NewFromString(maxString)
+	if err != nil {
+		return decimal.Decimal{}, decimal.Decimal{}, fmt.Errorf("failed to parse max: %w", err)
+	}
+
+	return minDec, maxDec, nil
+}
+
+// isSequential reports whether Generation_Rule.Unique makes the rule generate distinct values.
+func isSequential(rule *stroppy.Generation_Rule) bool {
+	switch rule.GetType().(type) {
+	case *stroppy.Generation_Rule_Int32Rules,
+		*stroppy.Generation_Rule_Int64Rules,
+		*stroppy.Generation_Rule_Uint32Rules,
+		*stroppy.Generation_Rule_Uint64Rules,
+		*stroppy.Generation_Rule_DatetimeRules:
+		return true
+	default:
+		return false
+	}
+}
+
+// hasConstant reports whether rule has a fixed value.
+func hasConstant(rule *stroppy.Generation_Rule) bool {
+	switch rule.GetType().(type) {
+	case *stroppy.Generation_Rule_FloatRules:
+		return rule.GetFloatRules().Constant != nil //nolint: protogetter // need pointer to detect presence
+	case *stroppy.Generation_Rule_DoubleRules:
+		return rule.GetDoubleRules().Constant != nil //nolint: protogetter // need pointer to detect presence
+	case *stroppy.Generation_Rule_Int32Rules:
+		return rule.GetInt32Rules().Constant != nil //nolint: protogetter // need pointer to detect presence
+	case *stroppy.Generation_Rule_Int64Rules:
+		return rule.GetInt64Rules().Constant != nil //nolint: protogetter // need pointer to detect presence
+	case *stroppy.Generation_Rule_Uint32Rules:
+		return rule.GetUint32Rules().Constant != nil //nolint: protogetter // need pointer to detect presence
+	case *stroppy.Generation_Rule_Uint64Rules:
+		return rule.GetUint64Rules().Constant != nil //nolint: protogetter // need pointer to detect presence
+	case *stroppy.Generation_Rule_BoolRules:
+		return rule.GetBoolRules().Constant != nil //nolint: protogetter // need pointer to detect presence
+	case *stroppy.Generation_Rule_StringRules:
+		return rule.GetStringRules().Constant != nil //nolint: protogetter // need pointer to detect presence
+	case *stroppy.Generation_Rule_DatetimeRules:
+		return rule.GetDatetimeRules().Constant != nil //nolint: protogetter // need pointer to detect presence
+	case *stroppy.Generation_Rule_UuidRules:
+		return rule.GetUuidRules().Constant != nil //nolint: protogetter // need pointer to detect presence
+	case *stroppy.Generation_Rule_DecimalRules:
+		return rule.GetDecimalRules().Constant != nil //nolint: protogetter // need pointer to detect presence
+	default:
+		return false
+	}
+}
+
+// distinctValues returns the number of distinct values rule can yield,
+// bounded is false when it is too large to be exhausted (floats, strings, uuids).
+// Ranges are expected to be already checked.
+func distinctValues(rule *stroppy.Generation_Rule) (distinct uint64, bounded bool) {
+	if hasConstant(rule) {
+		return 1, true
+	}
+
+	switch rule.GetType().(type) {
+	case *stroppy.Generation_Rule_Int32Rules:
+		r := rule.GetInt32Rules().GetRange()
+
+		return span(uint64(int64(r.GetMax()) - int64(r.GetMin()))), true
+	case *stroppy.Generation_Rule_Int64Rules:
+		r := rule.GetInt64Rules().GetRange()
+
+		return span(uint64(r.GetMax()) - uint64(r.GetMin())), true //nolint: gosec // two's complement difference
+	case *stroppy.Generation_Rule_Uint32Rules:
+		r := rule.GetUint32Rules().GetRange()
+
+		return span(uint64(r.GetMax() - r.GetMin())), true
+	case *stroppy.Generation_Rule_Uint64Rules:
+		r := rule.GetUint64Rules().GetRange()
+
+		return span(r.GetMax() - r.GetMin()), true
+	case *stroppy.Generation_Rule_BoolRules:
+		return 2, true //nolint: mnd // true and false
+	case *stroppy.Generation_Rule_DatetimeRules:
+		minTime, maxTime, _ := dateTimeBounds(rule.GetDatetimeRules().GetRange())
+
+		return span(uint64(maxTime.Unix() - minTime.Unix())), true //nolint: gosec // max >= min
+	default:
+		return 0, false
+	}
+}
+
+// span converts the difference between inclusive bounds to the number of values.
+func span(diff uint64) uint64 {
+	if diff == math.MaxUint64 {
+		return diff
+	}
+
+	return diff + 1
+}
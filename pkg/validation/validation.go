@@ -0,0 +1,233 @@
+package validation
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	stroppy "github.com/stroppy-io/stroppy-core/pkg/proto"
+	"github.com/stroppy-io/stroppy-core/pkg/queries"
+)
+
+// defaultParamRegex finds "${name}" references in SQL to detect params without descriptors.
+var defaultParamRegex = regexp.MustCompile(`\$\{([^}]+)\}`) //nolint: gochecknoglobals // compiled once
+
+// Error is a single semantic problem of BenchmarkDescriptor located by Path.
+type Error struct {
+	Path   string
+	Reason string
+}
+
+func (e *Error) Error() string {
+	return e.Path + ": " + e.Reason
+}
+
+// MultiError is returned by ValidateBenchmark and holds all found problems.
+type MultiError []error
+
+func (m MultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MultiError) AllErrors() []error { return m }
+
+// Unwrap allows to match every problem with errors.Is and errors.As.
+func (m MultiError) Unwrap() []error { return m }
+
+// ValidateBenchmark checks that benchmark is consistent beyond the generated Validate methods:
+// param regexes compile and match their SQL, every "${param}" in SQL is described,
+// index and insert columns exist in their tables, unique and primary key columns
+// can get enough distinct values and all generation ranges have min <= max.
+// Field shape violations of ValidateAll are returned as is, since semantic checks rely on them.
+func ValidateBenchmark(benchmark *stroppy.BenchmarkDescriptor) error {
+	if err := benchmark.ValidateAll(); err != nil {
+		return err
+	}
+
+	v := &validator{tables: make(map[string]*stroppy.TableDescriptor)}
+
+	for _, step := range benchmark.GetSteps() {
+		for _, unit := range step.GetUnits() {
+			if table := unit.GetCreateTable(); table != nil {
+				v.tables[table.GetName()] = table
+			}
+		}
+	}
+
+	for stepIdx, step := range benchmark.GetSteps() {
+		for unitIdx, unit := range step.GetUnits() {
+			v.unit(fmt.Sprintf("steps[%d].units[%d]", stepIdx, unitIdx), unit)
+		}
+	}
+
+	if len(v.problems) > 0 {
+		return v.problems
+	}
+
+	return nil
+}
+
+type validator struct {
+	tables   map[string]*stroppy.TableDescriptor
+	problems MultiError
+}
+
+func (v *validator) addf(path, format string, args ...any) {
+	v.problems = append(v.problems, &Error{Path: path, Reason: fmt.Sprintf(format, args...)})
+}
+
+func (v *validator) unit(path string, unit *stroppy.StepUnitDescriptor) {
+	switch unitType := unit.GetType().(type) {
+	case *stroppy.StepUnitDescriptor_CreateTable:
+		v.table(path+".create_table", unitType.CreateTable)
+	case *stroppy.StepUnitDescriptor_Query:
+		v.query(path+".query", unitType.Query, unitType.Query.GetCount())
+	case *stroppy.StepUnitDescriptor_Transaction:
+		for idx, query := range unitType.Transaction.GetQueries() {
+			v.query(
+				fmt.Sprintf("%s.transaction.queries[%d]", path, idx),
+				query,
+				query.GetCount()*unitType.Transaction.GetCount(),
+			)
+		}
+	case *stroppy.StepUnitDescriptor_Insert:
+		v.insert(path+".insert", unitType.Insert)
+	}
+}
+
+func (v *validator) table(path string, table *stroppy.TableDescriptor) {
+	columns := make(map[string]struct{}, len(table.GetColumns()))
+	for _, column := range table.GetColumns() {
+		columns[column.GetName()] = struct{}{}
+	}
+
+	for indexIdx, index := range table.GetTableIndexes() {
+		for columnIdx, column := range index.GetColumns() {
+			if _, ok := columns[column]; !ok {
+				v.addf(
+					fmt.Sprintf("%s.table_indexes[%d].columns[%d]", path, indexIdx, columnIdx),
+					"column '%s' does not exist in table '%s'", column, table.GetName(),
+				)
+			}
+		}
+	}
+}
+
+func (v *validator) query(path string, query *stroppy.QueryDescriptor, total uint64) {
+	covered := make([][]int, 0)
+
+	for idx, param := range query.GetParams() {
+		paramPath := fmt.Sprintf("%s.params[%d]", path, idx)
+
+		re, err := queries.CompileParamRegex(param)
+		if err != nil {
+			v.addf(paramPath+".replace_regex", "%s", err)
+
+			continue
+		}
+
+		matches := re.FindAllStringIndex(query.GetSql(), -1)
+		if len(matches) == 0 {
+			v.addf(paramPath+".replace_regex", "pattern '%s' does not match sql", re.String())
+		}
+
+		for _, match := range matches {
+			if match[0] == match[1] {
+				v.addf(paramPath+".replace_regex", "%s", queries.ErrEmptyParamMatch)
+
+				break
+			}
+		}
+
+		covered = append(covered, matches...)
+		v.rule(paramPath+".generation_rule", param.GetGenerationRule(), total, false)
+	}
+
+	for _, ref := range defaultParamRegex.FindAllStringSubmatchIndex(query.GetSql(), -1) {
+		if !isCovered(covered, ref[0], ref[1]) {
+			v.addf(path+".sql", "param '%s' is used in sql but not described", query.GetSql()[ref[2]:ref[3]])
+		}
+	}
+}
+
+func isCovered(matches [][]int, start, end int) bool {
+	for _, match := range matches {
+		if match[0] <= start && end <= match[1] {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (v *validator) insert(path string, insert *stroppy.InsertDescriptor) {
+	table, known := v.tables[insert.GetTableName()]
+
+	for idx, column := range insert.GetColumns() {
+		columnPath := fmt.Sprintf("%s.columns[%d]", path, idx)
+
+		if !known {
+			v.rule(columnPath+".generation_rule", column.GetGenerationRule(), insert.GetCount(), false)
+
+			continue
+		}
+
+		tableColumn := findColumn(table, column.GetName())
+		if tableColumn == nil {
+			v.addf(columnPath+".name", "column '%s' does not exist in table '%s'", column.GetName(), table.GetName())
+
+			continue
+		}
+
+		v.rule(
+			columnPath+".generation_rule",
+			column.GetGenerationRule(),
+			insert.GetCount(),
+			mustBeUnique(table, tableColumn),
+		)
+	}
+}
+
+func findColumn(table *stroppy.TableDescriptor, name string) *stroppy.ColumnDescriptor {
+	for _, column := range table.GetColumns() {
+		if column.GetName() == name {
+			return column
+		}
+	}
+
+	return nil
+}
+
+// mustBeUnique reports whether column is the only primary key column, unique or the only column of a unique index.
+// Columns of a composite primary key are unique only together.
+func mustBeUnique(table *stroppy.TableDescriptor, column *stroppy.ColumnDescriptor) bool {
+	if column.GetUnique() || (column.GetPrimaryKey() && primaryKeySize(table) == 1) {
+		return true
+	}
+
+	for _, index := range table.GetTableIndexes() {
+		if index.GetUnique() && len(index.GetColumns()) == 1 && index.GetColumns()[0] == column.GetName() {
+			return true
+		}
+	}
+
+	return false
+}
+
+func primaryKeySize(table *stroppy.TableDescriptor) int {
+	size := 0
+
+	for _, column := range table.GetColumns() {
+		if column.GetPrimaryKey() {
+			size++
+		}
+	}
+
+	return size
+}
@@ -0,0 +1,202 @@
+package validation
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	stroppy "github.com/stroppy-io/stroppy-core/pkg/proto"
+)
+
+func int64Rule(minValue, maxValue int64, unique bool) *stroppy.Generation_Rule {
+	return &stroppy.Generation_Rule{
+		Type: &stroppy.Generation_Rule_Int64Rules{
+			Int64Rules: &stroppy.Generation_Rules_Int64Rule{
+				Range: &stroppy.Generation_Range_Int64Range{Min: minValue, Max: maxValue},
+			},
+		},
+		Unique: proto.Bool(unique),
+	}
+}
+
+func validBenchmark() *stroppy.BenchmarkDescriptor {
+	return &stroppy.BenchmarkDescriptor{
+		Name: "bench",
+		Steps: []*stroppy.StepDescriptor{
+			{
+				Name: "schema",
+				Units: []*stroppy.StepUnitDescriptor{{
+					Type: &stroppy.StepUnitDescriptor_CreateTable{CreateTable: &stroppy.TableDescriptor{
+						Name: "accounts",
+						Columns: []*stroppy.ColumnDescriptor{
+							{Name: "id", SqlType: "BIGINT", PrimaryKey: true},
+							{Name: "balance", SqlType: "BIGINT"},
+						},
+						TableIndexes: []*stroppy.IndexDescriptor{{Name: "balance_idx", Columns: []string{"balance"}}},
+					}},
+				}},
+			},
+			{
+				Name: "fill",
+				Units: []*stroppy.StepUnitDescriptor{{
+					Type: &stroppy.StepUnitDescriptor_Insert{Insert: &stroppy.InsertDescriptor{
+						Name:      "fill_accounts",
+						TableName: "accounts",
+						Count:     100,
+						Columns: []*stroppy.InsertColumnDescriptor{
+							{Name: "id", GenerationRule: int64Rule(1, 100, true)},
+							{Name: "balance", GenerationRule: int64Rule(0, 10, false)},
+						},
+					}},
+				}},
+			},
+			{
+				Name: "workload",
+				Units: []*stroppy.StepUnitDescriptor{{
+					Type: &stroppy.StepUnitDescriptor_Query{Query: &stroppy.QueryDescriptor{
+						Name:  "select",
+						Sql:   "SELECT balance FROM accounts WHERE id = ${id}",
+						Count: 1000,
+						Params: []*stroppy.QueryParamDescriptor{
+							{Name: "id", GenerationRule: int64Rule(1, 100, false)},
+						},
+					}},
+				}},
+			},
+		},
+	}
+}
+
+func TestValidateBenchmark(t *testing.T) {
+	tests := []struct {
+		name   string
+		mutate func(benchmark *stroppy.BenchmarkDescriptor)
+		paths  []string
+	}{
+		{
+			name:   "valid",
+			mutate: func(*stroppy.BenchmarkDescriptor) {},
+		},
+		{
+			name: "bad regex",
+			mutate: func(benchmark *stroppy.BenchmarkDescriptor) {
+				benchmark.GetSteps()[2].GetUnits()[0].GetQuery().GetParams()[0].ReplaceRegex = "("
+			},
+			paths: []string{
+				"steps[2].units[0].query.params[0].replace_regex",
+				"steps[2].units[0].query.sql",
+			},
+		},
+		{
+			name: "regex does not match",
+			mutate: func(benchmark *stroppy.BenchmarkDescriptor) {
+				benchmark.GetSteps()[2].GetUnits()[0].GetQuery().Sql = "SELECT 1"
+			},
+			paths: []string{"steps[2].units[0].query.params[0].replace_regex"},
+		},
+		{
+			name: "undescribed param",
+			mutate: func(benchmark *stroppy.BenchmarkDescriptor) {
+				benchmark.GetSteps()[2].GetUnits()[0].GetQuery().Sql += " AND balance > ${balance}"
+			},
+			paths: []string{"steps[2].units[0].query.sql"},
+		},
+		{
+			name: "unknown index column",
+			mutate: func(benchmark *stroppy.BenchmarkDescriptor) {
+				benchmark.GetSteps()[0].GetUnits()[0].GetCreateTable().GetTableIndexes()[0].Columns = []string{"missing"}
+			},
+			paths: []string{"steps[0].units[0].create_table.table_indexes[0].columns[0]"},
+		},
+		{
+			name: "unknown insert column",
+			mutate: func(benchmark *stroppy.BenchmarkDescriptor) {
+				benchmark.GetSteps()[1].GetUnits()[0].GetInsert().GetColumns()[1].Name = "missing"
+			},
+			paths: []string{"steps[1].units[0].insert.columns[1].name"},
+		},
+		{
+			name: "primary key range too small",
+			mutate: func(benchmark *stroppy.BenchmarkDescriptor) {
+				benchmark.GetSteps()[1].GetUnits()[0].GetInsert().GetColumns()[0].GenerationRule = int64Rule(1, 10, true)
+			},
+			paths: []string{"steps[1].units[0].insert.columns[0].generation_rule"},
+		},
+		{
+			name: "primary key not unique",
+			mutate: func(benchmark *stroppy.BenchmarkDescriptor) {
+				benchmark.GetSteps()[1].GetUnits()[0].GetInsert().GetColumns()[0].GenerationRule = int64Rule(1, 1000, false)
+			},
+			paths: []string{"steps[1].units[0].insert.columns[0].generation_rule.unique"},
+		},
+		{
+			name: "composite primary key columns not unique",
+			mutate: func(benchmark *stroppy.BenchmarkDescriptor) {
+				benchmark.GetSteps()[0].GetUnits()[0].GetCreateTable().GetColumns()[1].PrimaryKey = true
+				benchmark.GetSteps()[1].GetUnits()[0].GetInsert().GetColumns()[0].GenerationRule = int64Rule(1, 10, false)
+			},
+		},
+		{
+			name: "unique param exhausted",
+			mutate: func(benchmark *stroppy.BenchmarkDescriptor) {
+				benchmark.GetSteps()[2].GetUnits()[0].GetQuery().GetParams()[0].GenerationRule = int64Rule(1, 100, true)
+			},
+			paths: []string{"steps[2].units[0].query.params[0].generation_rule"},
+		},
+		{
+			name: "min greater than max",
+			mutate: func(benchmark *stroppy.BenchmarkDescriptor) {
+				benchmark.GetSteps()[2].GetUnits()[0].GetQuery().GetParams()[0].GenerationRule = int64Rule(100, 1, false)
+				benchmark.GetSteps()[1].GetUnits()[0].GetInsert().GetColumns()[1].GenerationRule = int64Rule(10, 0, false)
+			},
+			paths: []string{
+				"steps[1].units[0].insert.columns[1].generation_rule.int64_rules.range",
+				"steps[2].units[0].query.params[0].generation_rule.int64_rules.range",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			benchmark := validBenchmark()
+			tt.mutate(benchmark)
+
+			err := ValidateBenchmark(benchmark)
+			if len(tt.paths) == 0 {
+				require.NoError(t, err)
+
+				return
+			}
+
+			var multiErr MultiError
+
+			require.ErrorAs(t, err, &multiErr)
+
+			paths := make([]string, 0, len(multiErr))
+
+			for _, problem := range multiErr.AllErrors() {
+				var validationErr *Error
+
+				require.True(t, errors.As(problem, &validationErr))
+
+				paths = append(paths, validationErr.Path)
+			}
+
+			require.Equal(t, tt.paths, paths)
+		})
+	}
+}
+
+func TestValidateBenchmark_ShapeErrors(t *testing.T) {
+	benchmark := validBenchmark()
+	benchmark.Name = ""
+
+	err := ValidateBenchmark(benchmark)
+	require.Error(t, err)
+
+	var multiErr MultiError
+
+	require.False(t, errors.As(err, &multiErr))
+}